@@ -181,6 +181,7 @@ func TestDiagnosticResultSet_NonProject(t *testing.T) {
 			},
 			Message:        "test message",
 			OriginalOutput: input,
+			Format:         "golint",
 		},
 	}})
 
@@ -400,6 +400,61 @@ func runList(w io.Writer) error {
 	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "rdjsonl", "Reviewdog Diagnostic JSONL Format (JSONL of Diagnostic message)", "https://github.com/reviewdog/reviewdog")
 	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "diff", "Unified Diff Format", "https://en.wikipedia.org/wiki/Diff#Unified_format")
 	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "checkstyle", "checkstyle XML format", "http://checkstyle.sourceforge.net/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "markdown-link-check", "markdown-link-check JSON format", "https://github.com/tcort/markdown-link-check")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "stylelint-compact", "stylelint compact text format", "https://stylelint.io/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "rdjson-framed", "Length-prefixed (4-byte big-endian) stream of rdjson documents", "https://github.com/reviewdog/reviewdog")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "junit", "JUnit XML format", "https://github.com/junit-team/junit5")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "cspell", "cspell JSON format (cspell --reporter json)", "https://github.com/streetsidesoftware/cspell")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "actionlint", "actionlint default text format", "https://github.com/rhysd/actionlint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "codeclimate", "Code Climate JSON format (GitLab Code Quality)", "https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "sqlfluff", "sqlfluff JSON format (sqlfluff lint --format json)", "https://github.com/sqlfluff/sqlfluff")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "sarif", "SARIF (Static Analysis Results Interchange Format) v2.1.0", "https://sarifweb.azurewebsites.net/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "golangci-lint-plain", "golangci-lint line-number format with optional severity", "https://github.com/golangci/golangci-lint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "vint", "vint (Vim script linter) text format", "https://github.com/Vimjas/vint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "commitlint", "commitlint JSON output (commitlint-format-json)", "https://github.com/conventional-changelog/commitlint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "bracket-tag", "(path:line[:col]: [CODE] message) e.g. salt-lint -p, ansible-lint -p", "https://github.com/reviewdog/reviewdog")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "gofmt-list", "(gofmt -l) list of unformatted files", "https://golang.org/cmd/gofmt/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "cfn-lint", "cfn-lint JSON output (cfn-lint --format json)", "https://github.com/aws-cloudformation/cfn-python-lint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "pyright", "pyright JSON output (pyright --outputjson)", "https://github.com/microsoft/pyright")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "misspell", "(path:line:col: \"wrong\" is a misspelling of \"right\") with suggestions", "https://github.com/client9/misspell")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "buildifier", "buildifier JSON output (buildifier --lint=warn --format=json)", "https://github.com/bazelbuild/buildtools/tree/master/buildifier")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "editorconfig-checker", "editorconfig-checker (ec) output grouped per file", "https://github.com/editorconfig-checker/editorconfig-checker")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "validation-list", "(KEYWORD - path: message or path: KEYWORD: message) e.g. kubeval, yamale", "https://github.com/reviewdog/reviewdog")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "tslint-json", "tslint JSON output (tslint -t json)", "https://palantir.github.io/tslint/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "codenarc", "CodeNarc (Groovy) XML report", "https://codenarc.github.io/CodeNarc/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "teamcity", "TeamCity inspection service messages (e.g. golangci-lint --out-format=teamcity)", "https://www.jetbrains.com/help/teamcity/service-messages.html")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "bundler-audit", "bundler-audit advisory blocks reported on Gemfile.lock", "https://github.com/rubysec/bundler-audit")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "proselint", "proselint JSON output (proselint --json)", "https://github.com/amperser/proselint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "kube-linter", "kube-linter JSON output (kube-linter lint --format=json)", "https://github.com/stackrox/kube-linter")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "alex", "alex JSON output (alex --json)", "https://github.com/get-alex/alex")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "tfsec", "tfsec JSON output (tfsec --format=json)", "https://github.com/aquasecurity/tfsec")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "golangci-lint-line-number", "golangci-lint line-number output (golangci-lint run --out-format=line-number)", "https://github.com/golangci/golangci-lint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "go-pos-json", "JSON objects with Pos and Message fields emitted by Go tool wrappers (e.g. nilaway, errcheck)", "https://github.com/uber-go/nilaway")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "github-actions", "GitHub Actions annotation commands (e.g. stylelint --formatter github)", "https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "kics", "KICS JSON output (kics scan --report-formats json)", "https://github.com/Checkmarx/kics")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "golangci-lint-checkstyle", "golangci-lint checkstyle output with linters as codes (golangci-lint run --out-format=checkstyle)", "https://github.com/golangci/golangci-lint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "status-table", "Tables of file statuses (FAIL path reason), e.g. licheck", "https://github.com/reviewdog/reviewdog")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "golangci-lint-json", "golangci-lint JSON output (golangci-lint run --out-format=json)", "https://github.com/golangci/golangci-lint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "gocyclo", "gocyclo and gocognit complexity output", "https://github.com/fzipp/gocyclo")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "npm-audit", "npm audit JSON output (npm audit --json)", "https://docs.npmjs.com/cli/commands/npm-audit")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "sbt-log", "sbt output with [error]/[warn]/[info] prefixes", "https://www.scala-sbt.org/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "errcheck", "errcheck default output", "https://github.com/kisielk/errcheck")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "deno-lint", "deno lint JSON output (deno lint --json)", "https://docs.deno.com/runtime/reference/cli/linter/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "gcc", "GCC and Clang diagnostics with notes as related locations", "https://gcc.gnu.org/onlinedocs/gcc/Diagnostic-Message-Formatting-Options.html")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "dash-separated", "path:line[:col] - message", "https://github.com/reviewdog/reviewdog")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "golangci-lint-tab", "golangci-lint tab output (--out-format=tab), optionally with severity column", "https://golangci-lint.run/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "woke", "woke text or JSON output (woke -o json)", "https://github.com/get-woke/woke")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "mypy-text", "mypy default text output", "https://mypy.readthedocs.io/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "phpmd", "PHPMD XML report", "https://phpmd.org/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "gosec-json", "gosec JSON output (gosec -fmt=json)", "https://github.com/securego/gosec")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "ktlint", "ktlint JSON output (ktlint --reporter=json)", "https://pinterest.github.io/ktlint/")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "hlint-json", "hlint JSON output (hlint --json)", "https://github.com/ndmitchell/hlint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "jscpd", "jscpd (copy/paste detector) JSON report (jscpd --reporters json)", "https://github.com/kucherenko/jscpd")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "typos", "typos JSON output (typos --format json)", "https://github.com/crate-ci/typos")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "nancy", "nancy text output (nancy sleuth)", "https://github.com/sonatype-nexus-community/nancy")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "cargo-audit", "cargo audit JSON output (cargo audit --json)", "https://github.com/rustsec/rustsec/tree/main/cargo-audit")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "golint-nocol", "golint-style output with optional column (path:line[:col]: message)", "https://github.com/golang/lint")
+	fmt.Fprintf(tabw, "%s\t%s\t- %s\n", "tsc-text", "TypeScript compiler output with continuation lines (tsc --pretty false)", "https://www.typescriptlang.org/docs/handbook/compiler-options.html")
 	for _, f := range sortedFmts(fmts.DefinedFmts()) {
 		fmt.Fprintf(tabw, "%s\t%s\t- %s\n", f.Name, f.Description, f.URL)
 	}
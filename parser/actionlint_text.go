@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &ActionlintTextParser{}
+
+// ActionlintTextParser is parser for actionlint default text output.
+// https://github.com/rhysd/actionlint
+type ActionlintTextParser struct{}
+
+// NewActionlintTextParser returns a new ActionlintTextParser.
+func NewActionlintTextParser() *ActionlintTextParser {
+	return &ActionlintTextParser{}
+}
+
+// path:line:col: message [kind]
+var actionlintTextRe = regexp.MustCompile(`^(.+?):(\d+):(\d+): (.*) \[([^\]]+)\]$`)
+
+// Parse parses actionlint default output. Each error consists of a header
+// line followed by the source snippet and a caret line, which are kept in
+// Lines of the diagnostic. Original output is the header line.
+//
+//	.github/workflows/test.yaml:3:5: unexpected key "branch" for "push" section [syntax-check]
+//	  |
+//	3 |     branch: main
+//	  |     ^~~~~~~
+func (p *ActionlintTextParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	var (
+		cur   *rdf.Diagnostic
+		lines []string
+	)
+	flush := func() {
+		if cur != nil {
+			cur.Lines = lines
+			ds = append(ds, cur)
+		}
+		cur, lines = nil, nil
+	}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := actionlintTextRe.FindStringSubmatch(line)
+		if m == nil {
+			if cur != nil && strings.TrimSpace(line) != "" {
+				lines = append(lines, line)
+			} else {
+				flush()
+			}
+			continue
+		}
+		flush()
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		cur = &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[4],
+			Severity:       rdf.Severity_ERROR,
+			Code:           &rdf.Code{Value: m[5]},
+			Format:         "actionlint",
+			OriginalOutput: line,
+		}
+	}
+	flush()
+	return ds, s.Err()
+}
@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleActionlintTextParser() {
+	const sample = `.github/workflows/test.yaml:3:5: unexpected key "branch" for "push" section. expected one of "branches", "branches-ignore", "paths", "paths-ignore", "tags", "tags-ignore", "types", "workflows" [syntax-check]
+  |
+3 |     branch: main
+  |     ^~~~~~~
+.github/workflows/test.yaml:10:28: label "linux-latest" is unknown. available labels are "ubuntu-latest", "ubuntu-22.04", "macos-latest", "windows-latest" [runner-label]
+   |
+10 |     runs-on: [self-hosted, linux-latest]
+   |                            ^~~~~~~~~~~~
+`
+
+	p := NewActionlintTextParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "unexpected key \"branch\" for \"push\" section. expected one of \"branches\", \"branches-ignore\", \"paths\", \"paths-ignore\", \"tags\", \"tags-ignore\", \"types\", \"workflows\"",
+	//   "location": {
+	//     "path": ".github/workflows/test.yaml",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "syntax-check"
+	//   },
+	//   "originalOutput": ".github/workflows/test.yaml:3:5: unexpected key \"branch\" for \"push\" section. expected one of \"branches\", \"branches-ignore\", \"paths\", \"paths-ignore\", \"tags\", \"tags-ignore\", \"types\", \"workflows\" [syntax-check]",
+	//   "format": "actionlint",
+	//   "lines": [
+	//     "  |",
+	//     "3 |     branch: main",
+	//     "  |     ^~~~~~~"
+	//   ]
+	// }
+	// {
+	//   "message": "label \"linux-latest\" is unknown. available labels are \"ubuntu-latest\", \"ubuntu-22.04\", \"macos-latest\", \"windows-latest\"",
+	//   "location": {
+	//     "path": ".github/workflows/test.yaml",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 28
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "runner-label"
+	//   },
+	//   "originalOutput": ".github/workflows/test.yaml:10:28: label \"linux-latest\" is unknown. available labels are \"ubuntu-latest\", \"ubuntu-22.04\", \"macos-latest\", \"windows-latest\" [runner-label]",
+	//   "format": "actionlint",
+	//   "lines": [
+	//     "   |",
+	//     "10 |     runs-on: [self-hosted, linux-latest]",
+	//     "   |                            ^~~~~~~~~~~~"
+	//   ]
+	// }
+}
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &AdvisoryBlockParser{}
+
+// AdvisoryBlockOption configures AdvisoryBlockParser.
+type AdvisoryBlockOption struct {
+	// Path is the path of the lockfile (e.g. Gemfile.lock) on which
+	// diagnostics are reported.
+	Path string
+	// IDLabels are labels of advisory IDs in the order of preference.
+	// Default: Advisory, CVE, GHSA.
+	IDLabels []string
+	// SeverityLabel is the label of severity. Default: Criticality.
+	SeverityLabel string
+}
+
+// AdvisoryBlockParser is parser for dependency scan results printed as
+// labeled blocks separated by blank lines, such as bundler-audit output.
+//
+//	Name: actionpack
+//	Version: 3.2.10
+//	Advisory: OSVDB-91452
+//	Criticality: Medium
+//	URL: http://www.osvdb.org/show/osvdb/91452
+//	Title: XSS vulnerability in sanitize_css in Action Pack
+//	Solution: upgrade to >= 3.2.13
+//
+// Each block is reported as a file-level diagnostic on the lockfile. Lines
+// without label and blocks without Name are ignored.
+type AdvisoryBlockParser struct {
+	opt AdvisoryBlockOption
+	// format is the format name set in diagnostics.
+	format string
+}
+
+// NewAdvisoryBlockParser returns a new AdvisoryBlockParser.
+func NewAdvisoryBlockParser(opt AdvisoryBlockOption) *AdvisoryBlockParser {
+	if len(opt.IDLabels) == 0 {
+		opt.IDLabels = []string{"Advisory", "CVE", "GHSA"}
+	}
+	if opt.SeverityLabel == "" {
+		opt.SeverityLabel = "Criticality"
+	}
+	return &AdvisoryBlockParser{opt: opt, format: "advisory-block"}
+}
+
+// Parse parses labeled advisory blocks.
+func (p *AdvisoryBlockParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	block := make(map[string]string)
+	var lines []string
+	flush := func() {
+		if block["Name"] != "" {
+			ds = append(ds, p.buildDiagnostic(block, strings.Join(lines, "\n")))
+		}
+		block = make(map[string]string)
+		lines = nil
+	}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if strings.TrimSpace(s.Text()) == "" {
+			flush()
+			continue
+		}
+		i := strings.Index(s.Text(), ": ")
+		if i < 0 {
+			continue
+		}
+		block[s.Text()[:i]] = strings.TrimSpace(s.Text()[i+2:])
+		lines = append(lines, s.Text())
+	}
+	flush()
+	return ds, s.Err()
+}
+
+func (p *AdvisoryBlockParser) buildDiagnostic(block map[string]string, original string) *rdf.Diagnostic {
+	pkg := strings.TrimSpace(block["Name"] + " " + block["Version"])
+	msg := pkg
+	if title := block["Title"]; title != "" {
+		msg = fmt.Sprintf("%s: %s", pkg, title)
+	}
+	if solution := block["Solution"]; solution != "" {
+		msg += "\nSolution: " + solution
+	}
+	d := &rdf.Diagnostic{
+		Location:       &rdf.Location{Path: p.opt.Path},
+		Message:        msg,
+		Severity:       ParseSeverity(block[p.opt.SeverityLabel]),
+		Format:         p.format,
+		OriginalOutput: original,
+	}
+	for _, label := range p.opt.IDLabels {
+		if id := block[label]; id != "" {
+			d.Code = &rdf.Code{Value: id, Url: block["URL"]}
+			break
+		}
+	}
+	return d
+}
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleAdvisoryBlockParser() {
+	// bundle-audit check
+	const sample = `Name: actionpack
+Version: 3.2.10
+Advisory: OSVDB-91452
+Criticality: Medium
+URL: http://www.osvdb.org/show/osvdb/91452
+Title: XSS vulnerability in sanitize_css in Action Pack
+Solution: upgrade to ~> 2.3.18, ~> 3.1.12, >= 3.2.13
+
+Name: nokogiri
+Version: 1.10.3
+CVE: CVE-2019-5477
+GHSA: GHSA-cr5j-953j-xw5p
+Criticality: High
+URL: https://github.com/sparklemotion/nokogiri/issues/1915
+Title: Nokogiri Command Injection Vulnerability
+Solution: upgrade to >= 1.10.4
+
+Vulnerabilities found!
+`
+
+	p := NewAdvisoryBlockParser(AdvisoryBlockOption{Path: "Gemfile.lock"})
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "actionpack 3.2.10: XSS vulnerability in sanitize_css in Action Pack\nSolution: upgrade to ~> 2.3.18, ~> 3.1.12, >= 3.2.13",
+	//   "location": {
+	//     "path": "Gemfile.lock"
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "OSVDB-91452",
+	//     "url": "http://www.osvdb.org/show/osvdb/91452"
+	//   },
+	//   "originalOutput": "Name: actionpack\nVersion: 3.2.10\nAdvisory: OSVDB-91452\nCriticality: Medium\nURL: http://www.osvdb.org/show/osvdb/91452\nTitle: XSS vulnerability in sanitize_css in Action Pack\nSolution: upgrade to ~> 2.3.18, ~> 3.1.12, >= 3.2.13",
+	//   "format": "advisory-block"
+	// }
+	// {
+	//   "message": "nokogiri 1.10.3: Nokogiri Command Injection Vulnerability\nSolution: upgrade to >= 1.10.4",
+	//   "location": {
+	//     "path": "Gemfile.lock"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "CVE-2019-5477",
+	//     "url": "https://github.com/sparklemotion/nokogiri/issues/1915"
+	//   },
+	//   "originalOutput": "Name: nokogiri\nVersion: 1.10.3\nCVE: CVE-2019-5477\nGHSA: GHSA-cr5j-953j-xw5p\nCriticality: High\nURL: https://github.com/sparklemotion/nokogiri/issues/1915\nTitle: Nokogiri Command Injection Vulnerability\nSolution: upgrade to >= 1.10.4",
+	//   "format": "advisory-block"
+	// }
+}
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &AlexParser{}
+
+// AlexParser is parser for alex JSON output (alex --json), which is a list of
+// VFiles.
+// https://github.com/get-alex/alex
+type AlexParser struct{}
+
+// NewAlexParser returns a new AlexParser.
+func NewAlexParser() *AlexParser {
+	return &AlexParser{}
+}
+
+// Parse parses alex JSON output.
+func (p *AlexParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var files []*VFile
+	if err := json.NewDecoder(r).Decode(&files); err != nil {
+		return nil, fmt.Errorf("failed to decode alex JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, f := range files {
+		path := f.filePath()
+		for _, m := range f.Messages {
+			rng := &rdf.Range{Start: &rdf.Position{Line: int32(m.Line), Column: int32(m.Column)}}
+			if end := m.Location.End; end.Line > 0 {
+				rng.End = &rdf.Position{Line: int32(end.Line), Column: int32(end.Column)}
+			}
+			d := &rdf.Diagnostic{
+				Location: &rdf.Location{
+					Path:  path,
+					Range: rng,
+				},
+				Message:        m.Reason,
+				Severity:       rdf.Severity_WARNING,
+				Format:         "alex",
+				OriginalOutput: fmt.Sprintf("%s:%d:%d: %s %s %s", path, m.Line, m.Column, m.Reason, m.RuleID, m.Source),
+			}
+			if m.RuleID != "" {
+				d.Code = &rdf.Code{Value: m.RuleID}
+			}
+			if m.Source != "" {
+				d.Source = &rdf.Source{Name: m.Source}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+// VFile represents a virtual file of the unified ecosystem (e.g. alex,
+// remark-lint).
+// {"path":"README.md","history":["README.md"],"messages":[{"reason":"msg","line":1,"column":1,"location":{"start":{"line":1,"column":1},"end":{"line":1,"column":3}},"source":"retext-equality","ruleId":"he-she"}]}
+type VFile struct {
+	Path     string          `json:"path"`
+	History  []string        `json:"history"`
+	Messages []*VFileMessage `json:"messages"`
+}
+
+func (f *VFile) filePath() string {
+	if f.Path == "" && len(f.History) > 0 {
+		return f.History[len(f.History)-1]
+	}
+	return f.Path
+}
+
+// VFileMessage represents a message of VFile. Line and column are 1-based.
+type VFileMessage struct {
+	Reason   string `json:"reason"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Location struct {
+		End struct {
+			Line   int `json:"line"`
+			Column int `json:"column"`
+		} `json:"end"`
+	} `json:"location"`
+	Source string `json:"source"`
+	RuleID string `json:"ruleId"`
+}
@@ -0,0 +1,118 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleAlexParser() {
+	// alex --json README.md
+	const sample = `[
+  {
+    "path": "README.md",
+    "cwd": "/home/user/project",
+    "history": ["README.md"],
+    "messages": [
+      {
+        "reason": "` + "`he`" + ` may be insensitive, use ` + "`they`" + `, ` + "`it`" + ` instead",
+        "line": 3,
+        "column": 11,
+        "location": {
+          "start": {"line": 3, "column": 11, "offset": 40},
+          "end": {"line": 3, "column": 13, "offset": 42}
+        },
+        "source": "retext-equality",
+        "ruleId": "he-she",
+        "fatal": false,
+        "actual": "he",
+        "expected": ["they", "it"]
+      }
+    ]
+  },
+  {
+    "path": "",
+    "history": ["docs/guide.md"],
+    "messages": [
+      {
+        "reason": "Be careful with ` + "`fires`" + `, it’s profane in some cases",
+        "line": 7,
+        "column": 1,
+        "location": {
+          "start": {"line": 7, "column": 1, "offset": 120},
+          "end": {"line": 7, "column": 6, "offset": 125}
+        },
+        "source": "retext-profanities",
+        "ruleId": "fires",
+        "fatal": false
+      }
+    ]
+  }
+]`
+
+	p := NewAlexParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "`he` may be insensitive, use `they`, `it` instead",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 11
+	//       },
+	//       "end": {
+	//         "line": 3,
+	//         "column": 13
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "source": {
+	//     "name": "retext-equality"
+	//   },
+	//   "code": {
+	//     "value": "he-she"
+	//   },
+	//   "originalOutput": "README.md:3:11: `he` may be insensitive, use `they`, `it` instead he-she retext-equality",
+	//   "format": "alex"
+	// }
+	// {
+	//   "message": "Be careful with `fires`, it’s profane in some cases",
+	//   "location": {
+	//     "path": "docs/guide.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 7,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 7,
+	//         "column": 6
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "source": {
+	//     "name": "retext-profanities"
+	//   },
+	//   "code": {
+	//     "value": "fires"
+	//   },
+	//   "originalOutput": "docs/guide.md:7:1: Be careful with `fires`, it’s profane in some cases fires retext-profanities",
+	//   "format": "alex"
+	// }
+}
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &BracketTagParser{}
+
+// BracketTagParser is parser for output like `path:line[:col]: [CODE] message`,
+// which is used by several tools such as salt-lint and ansible-lint with
+// parseable output (-p).
+//
+// Severity is derived from the leading letter of CODE (E/F: ERROR,
+// W: WARNING, I/C/N: INFO).
+type BracketTagParser struct{}
+
+// NewBracketTagParser returns a new BracketTagParser.
+func NewBracketTagParser() *BracketTagParser {
+	return &BracketTagParser{}
+}
+
+// path:line[:col]:[ ][CODE] message
+var bracketTagRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?: ?\[([^\]]+)\] (.*)$`)
+
+// Parse parses output with bracketed codes. Lines in other formats are
+// ignored.
+func (p *BracketTagParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := bracketTagRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		pos := &rdf.Position{Line: int32(lnum)}
+		if m[3] != "" {
+			col, _ := strconv.Atoi(m[3])
+			pos.Column = int32(col)
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path:  m[1],
+				Range: &rdf.Range{Start: pos},
+			},
+			Message:        m[5],
+			Severity:       bracketTagSeverity(m[4]),
+			Code:           &rdf.Code{Value: m[4]},
+			Format:         "bracket-tag",
+			OriginalOutput: s.Text(),
+		})
+	}
+	return ds, s.Err()
+}
+
+func bracketTagSeverity(code string) rdf.Severity {
+	switch code[0] {
+	case 'E', 'F':
+		return rdf.Severity_ERROR
+	case 'W':
+		return rdf.Severity_WARNING
+	case 'I', 'C', 'N':
+		return rdf.Severity_INFO
+	default:
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+}
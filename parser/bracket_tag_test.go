@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleBracketTagParser() {
+	// salt-lint -p
+	const sample = `init.sls:5: [E204] Lines should be no longer that 160 chars
+init.sls:12: [W207] Nested JINJA pattern
+salt-lint: done
+`
+
+	p := NewBracketTagParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Lines should be no longer that 160 chars",
+	//   "location": {
+	//     "path": "init.sls",
+	//     "range": {
+	//       "start": {
+	//         "line": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "E204"
+	//   },
+	//   "originalOutput": "init.sls:5: [E204] Lines should be no longer that 160 chars",
+	//   "format": "bracket-tag"
+	// }
+	// {
+	//   "message": "Nested JINJA pattern",
+	//   "location": {
+	//     "path": "init.sls",
+	//     "range": {
+	//       "start": {
+	//         "line": 12
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "W207"
+	//   },
+	//   "originalOutput": "init.sls:12: [W207] Nested JINJA pattern",
+	//   "format": "bracket-tag"
+	// }
+}
+
+func ExampleBracketTagParser_ansibleLint() {
+	// ansible-lint -p
+	const sample = `playbook.yml:3: [E301] Commands should not change things if nothing needs doing
+roles/web/tasks/main.yml:10:5: [C401] Use shell only when shell functionality is required
+`
+
+	p := NewBracketTagParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Commands should not change things if nothing needs doing",
+	//   "location": {
+	//     "path": "playbook.yml",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "E301"
+	//   },
+	//   "originalOutput": "playbook.yml:3: [E301] Commands should not change things if nothing needs doing",
+	//   "format": "bracket-tag"
+	// }
+	// {
+	//   "message": "Use shell only when shell functionality is required",
+	//   "location": {
+	//     "path": "roles/web/tasks/main.yml",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "C401"
+	//   },
+	//   "originalOutput": "roles/web/tasks/main.yml:10:5: [C401] Use shell only when shell functionality is required",
+	//   "format": "bracket-tag"
+	// }
+}
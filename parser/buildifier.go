@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &BuildifierParser{}
+
+// BuildifierParser is parser for buildifier JSON output
+// (buildifier --lint=warn --format=json).
+// https://github.com/bazelbuild/buildtools/tree/master/buildifier
+type BuildifierParser struct{}
+
+// NewBuildifierParser returns a new BuildifierParser.
+func NewBuildifierParser() *BuildifierParser {
+	return &BuildifierParser{}
+}
+
+// Parse parses buildifier JSON output.
+func (p *BuildifierParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result BuildifierResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode buildifier JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, f := range result.Files {
+		for _, w := range f.Warnings {
+			rng := &rdf.Range{
+				Start: w.Start.rdfPosition(),
+				End:   w.End.rdfPosition(),
+			}
+			d := &rdf.Diagnostic{
+				Location: &rdf.Location{
+					Path:  f.Filename,
+					Range: rng,
+				},
+				Message:  w.Message,
+				Severity: rdf.Severity_WARNING,
+				Format:   "buildifier",
+				OriginalOutput: fmt.Sprintf("%s:%d:%d: %s: %s (%s)", f.Filename,
+					rng.GetStart().GetLine(), rng.GetStart().GetColumn(), w.Category, w.Message, w.URL),
+			}
+			if w.Category != "" {
+				d.Code = &rdf.Code{Value: w.Category, Url: w.URL}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+// BuildifierResult represents buildifier JSON output.
+// {"success":false,"files":[{"filename":"BUILD","formatted":true,"valid":true,"warnings":[{"start":{"line":1,"column":1},"end":{"line":1,"column":10},"category":"load","actionable":true,"message":"msg","url":"https://..."}]}]}
+type BuildifierResult struct {
+	Files []*BuildifierFile `json:"files"`
+}
+
+// BuildifierFile represents a checked file.
+type BuildifierFile struct {
+	Filename string               `json:"filename"`
+	Warnings []*BuildifierWarning `json:"warnings"`
+}
+
+// BuildifierWarning represents a lint warning.
+type BuildifierWarning struct {
+	Start      *BuildifierPosition `json:"start"`
+	End        *BuildifierPosition `json:"end"`
+	Category   string              `json:"category"`
+	Actionable bool                `json:"actionable"`
+	Message    string              `json:"message"`
+	URL        string              `json:"url"`
+}
+
+// BuildifierPosition represents a position. Both line and column are 1-based.
+type BuildifierPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (p *BuildifierPosition) rdfPosition() *rdf.Position {
+	if p == nil {
+		return nil
+	}
+	return &rdf.Position{Line: int32(p.Line), Column: int32(p.Column)}
+}
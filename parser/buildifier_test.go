@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleBuildifierParser() {
+	// buildifier --lint=warn --mode=check --format=json -r .
+	const sample = `{
+  "success": false,
+  "files": [
+    {
+      "filename": "BUILD.bazel",
+      "formatted": true,
+      "valid": true,
+      "warnings": [
+        {
+          "start": {"line": 1, "column": 1},
+          "end": {"line": 1, "column": 52},
+          "category": "module-docstring",
+          "actionable": true,
+          "message": "The file has no module docstring.",
+          "url": "https://github.com/bazelbuild/buildtools/blob/master/WARNINGS.md#module-docstring"
+        }
+      ]
+    },
+    {
+      "filename": "pkg/BUILD",
+      "formatted": false,
+      "valid": true,
+      "warnings": [
+        {
+          "start": {"line": 3, "column": 5},
+          "end": {"line": 5, "column": 6},
+          "category": "native-cc",
+          "actionable": false,
+          "message": "Function \"cc_library\" is not global anymore and needs to be loaded from \"@rules_cc//cc:defs.bzl\".",
+          "url": "https://github.com/bazelbuild/buildtools/blob/master/WARNINGS.md#native-cc"
+        }
+      ]
+    }
+  ]
+}`
+
+	p := NewBuildifierParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "The file has no module docstring.",
+	//   "location": {
+	//     "path": "BUILD.bazel",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 1,
+	//         "column": 52
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "module-docstring",
+	//     "url": "https://github.com/bazelbuild/buildtools/blob/master/WARNINGS.md#module-docstring"
+	//   },
+	//   "originalOutput": "BUILD.bazel:1:1: module-docstring: The file has no module docstring. (https://github.com/bazelbuild/buildtools/blob/master/WARNINGS.md#module-docstring)",
+	//   "format": "buildifier"
+	// }
+	// {
+	//   "message": "Function \"cc_library\" is not global anymore and needs to be loaded from \"@rules_cc//cc:defs.bzl\".",
+	//   "location": {
+	//     "path": "pkg/BUILD",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 5
+	//       },
+	//       "end": {
+	//         "line": 5,
+	//         "column": 6
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "native-cc",
+	//     "url": "https://github.com/bazelbuild/buildtools/blob/master/WARNINGS.md#native-cc"
+	//   },
+	//   "originalOutput": "pkg/BUILD:3:5: native-cc: Function \"cc_library\" is not global anymore and needs to be loaded from \"@rules_cc//cc:defs.bzl\". (https://github.com/bazelbuild/buildtools/blob/master/WARNINGS.md#native-cc)",
+	//   "format": "buildifier"
+	// }
+}
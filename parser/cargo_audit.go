@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &CargoAuditParser{}
+
+// cargoAuditPath is the path cargo audit results are reported on.
+const cargoAuditPath = "Cargo.lock"
+
+// CargoAuditParser is parser for cargo audit JSON output (cargo audit --json).
+// https://github.com/rustsec/rustsec/tree/main/cargo-audit
+type CargoAuditParser struct{}
+
+// NewCargoAuditParser returns a new CargoAuditParser.
+func NewCargoAuditParser() *CargoAuditParser {
+	return &CargoAuditParser{}
+}
+
+// Parse parses cargo audit JSON output. Each vulnerability is reported as a
+// file-level ERROR on Cargo.lock with the advisory id as Code.
+func (p *CargoAuditParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var report CargoAuditReport
+	if err := json.NewDecoder(r).Decode(&report); err != nil {
+		return nil, fmt.Errorf("failed to decode cargo audit JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, v := range report.Vulnerabilities.List {
+		a := v.Advisory
+		msg := fmt.Sprintf("%s %s: %s", v.Package.Name, v.Package.Version, a.Title)
+		if len(v.Versions.Patched) > 0 {
+			msg += fmt.Sprintf(" (patched: %s)", strings.Join(v.Versions.Patched, ", "))
+		}
+		url := a.URL
+		if url == "" && a.ID != "" {
+			url = "https://rustsec.org/advisories/" + a.ID
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: cargoAuditPath},
+			Message:        msg,
+			Severity:       rdf.Severity_ERROR,
+			Code:           &rdf.Code{Value: a.ID, Url: url},
+			Format:         "cargo-audit",
+			OriginalOutput: fmt.Sprintf("%s: %s", a.ID, msg),
+		})
+	}
+	return ds, nil
+}
+
+// CargoAuditReport represents cargo audit JSON output.
+// {"vulnerabilities":{"found":true,"count":1,"list":[{"advisory":{"id":"RUSTSEC-2020-0071","title":"Potential segfault in the time crate","url":"https://github.com/time-rs/time/issues/293"},"versions":{"patched":[">=0.2.23"]},"package":{"name":"time","version":"0.1.45"}}]}}
+type CargoAuditReport struct {
+	Vulnerabilities CargoAuditVulnerabilities `json:"vulnerabilities"`
+}
+
+// CargoAuditVulnerabilities represents found vulnerabilities.
+type CargoAuditVulnerabilities struct {
+	Found bool                       `json:"found"`
+	Count int                        `json:"count"`
+	List  []*CargoAuditVulnerability `json:"list"`
+}
+
+// CargoAuditVulnerability represents a vulnerability of a package.
+type CargoAuditVulnerability struct {
+	Advisory CargoAuditAdvisory `json:"advisory"`
+	Versions CargoAuditVersions `json:"versions"`
+	Package  CargoAuditPackage  `json:"package"`
+}
+
+// CargoAuditAdvisory represents a RustSec advisory. URL may be empty.
+type CargoAuditAdvisory struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// CargoAuditVersions represents patched versions of a vulnerability.
+type CargoAuditVersions struct {
+	Patched []string `json:"patched"`
+}
+
+// CargoAuditPackage represents a package in Cargo.lock.
+type CargoAuditPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
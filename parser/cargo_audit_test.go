@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleCargoAuditParser() {
+	// cargo audit --json
+	const sample = `{
+  "database": {"advisory-count": 500},
+  "lockfile": {"dependency-count": 120},
+  "vulnerabilities": {
+    "found": true,
+    "count": 2,
+    "list": [
+      {
+        "advisory": {
+          "id": "RUSTSEC-2020-0071",
+          "package": "time",
+          "title": "Potential segfault in the time crate",
+          "url": "https://github.com/time-rs/time/issues/293"
+        },
+        "versions": {"patched": [">=0.2.23"], "unaffected": ["=0.2.0"]},
+        "package": {"name": "time", "version": "0.1.45"}
+      },
+      {
+        "advisory": {
+          "id": "RUSTSEC-2021-0139",
+          "package": "ansi_term",
+          "title": "ansi_term is Unmaintained",
+          "url": null
+        },
+        "versions": {"patched": [], "unaffected": []},
+        "package": {"name": "ansi_term", "version": "0.12.1"}
+      }
+    ]
+  },
+  "warnings": {}
+}`
+
+	p := NewCargoAuditParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "time 0.1.45: Potential segfault in the time crate (patched: >=0.2.23)",
+	//   "location": {
+	//     "path": "Cargo.lock"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "RUSTSEC-2020-0071",
+	//     "url": "https://github.com/time-rs/time/issues/293"
+	//   },
+	//   "originalOutput": "RUSTSEC-2020-0071: time 0.1.45: Potential segfault in the time crate (patched: >=0.2.23)",
+	//   "format": "cargo-audit"
+	// }
+	// {
+	//   "message": "ansi_term 0.12.1: ansi_term is Unmaintained",
+	//   "location": {
+	//     "path": "Cargo.lock"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "RUSTSEC-2021-0139",
+	//     "url": "https://rustsec.org/advisories/RUSTSEC-2021-0139"
+	//   },
+	//   "originalOutput": "RUSTSEC-2021-0139: ansi_term 0.12.1: ansi_term is Unmaintained",
+	//   "format": "cargo-audit"
+	// }
+}
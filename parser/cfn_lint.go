@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &CfnLintParser{}
+
+// CfnLintParser is parser for cfn-lint JSON output (cfn-lint --format json).
+// https://github.com/aws-cloudformation/cfn-python-lint
+type CfnLintParser struct {
+	preserveRaw bool
+}
+
+// NewCfnLintParser returns a new CfnLintParser.
+func NewCfnLintParser() *CfnLintParser {
+	return &CfnLintParser{}
+}
+
+// Parse parses cfn-lint JSON output.
+func (p *CfnLintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var matches []*CfnLintMatch
+	if err := json.NewDecoder(r).Decode(&matches); err != nil {
+		return nil, fmt.Errorf("failed to decode cfn-lint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, m := range matches {
+		rng := &rdf.Range{
+			Start: m.Location.Start.rdfPosition(),
+			End:   m.Location.End.rdfPosition(),
+		}
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path:  m.Location.Filename,
+				Range: rng,
+			},
+			Message:  m.Message,
+			Severity: ParseSeverity(m.Level),
+			Format:   "cfn-lint",
+			OriginalOutput: fmt.Sprintf("%s %s\n%s:%d:%d", m.Rule.ID, m.Message,
+				m.Location.Filename, rng.GetStart().GetLine(), rng.GetStart().GetColumn()),
+		}
+		if m.Rule.ID != "" {
+			d.Code = &rdf.Code{Value: m.Rule.ID, Url: m.Rule.Source}
+		}
+		if p.preserveRaw {
+			d.Raw = m.raw
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// CfnLintMatch represents a rule match of cfn-lint JSON output.
+// {"Rule":{"Id":"E3012","Description":"Check resource properties values","Source":"https://..."},"Level":"Error","Message":"msg","Location":{"Start":{"LineNumber":5,"ColumnNumber":7},"End":{"LineNumber":5,"ColumnNumber":17},"Filename":"template.yaml"}}
+type CfnLintMatch struct {
+	Rule     CfnLintRule     `json:"Rule"`
+	Level    string          `json:"Level"`
+	Message  string          `json:"Message"`
+	Location CfnLintLocation `json:"Location"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler to keep the raw JSON.
+func (m *CfnLintMatch) UnmarshalJSON(b []byte) error {
+	type match CfnLintMatch // Avoid recursion.
+	return unmarshalRaw(b, (*match)(m), &m.raw)
+}
+
+// CfnLintRule represents a cfn-lint rule.
+type CfnLintRule struct {
+	ID          string `json:"Id"`
+	Description string `json:"Description"`
+	Source      string `json:"Source"`
+}
+
+// CfnLintLocation represents location of a match.
+type CfnLintLocation struct {
+	Start    *CfnLintPosition `json:"Start"`
+	End      *CfnLintPosition `json:"End"`
+	Filename string           `json:"Filename"`
+}
+
+// CfnLintPosition represents a position. Both line and column are 1-based.
+type CfnLintPosition struct {
+	LineNumber   int `json:"LineNumber"`
+	ColumnNumber int `json:"ColumnNumber"`
+}
+
+func (p *CfnLintPosition) rdfPosition() *rdf.Position {
+	if p == nil {
+		return nil
+	}
+	return &rdf.Position{Line: int32(p.LineNumber), Column: int32(p.ColumnNumber)}
+}
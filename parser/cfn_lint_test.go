@@ -0,0 +1,107 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleCfnLintParser() {
+	// cfn-lint --format json template.yaml
+	const sample = `[
+  {
+    "Filename": "template.yaml",
+    "Level": "Error",
+    "Location": {
+      "End": {"ColumnNumber": 17, "LineNumber": 5},
+      "Filename": "template.yaml",
+      "Path": ["Resources", "Bucket", "Type"],
+      "Start": {"ColumnNumber": 7, "LineNumber": 5}
+    },
+    "Message": "Invalid or unsupported Type AWS::S3::Buckett for resource Bucket in us-east-1",
+    "Rule": {
+      "Description": "Making sure the basic CloudFormation resources are properly configured",
+      "Id": "E3001",
+      "ShortDescription": "Basic CloudFormation Resource Check",
+      "Source": "https://github.com/aws-cloudformation/cfn-python-lint"
+    }
+  },
+  {
+    "Filename": "template.yaml",
+    "Level": "Informational",
+    "Location": {
+      "End": {"ColumnNumber": 12, "LineNumber": 10},
+      "Filename": "template.yaml",
+      "Start": {"ColumnNumber": 3, "LineNumber": 10}
+    },
+    "Message": "Parameter Env not used.",
+    "Rule": {
+      "Description": "Making sure the parameters defined are used",
+      "Id": "I2001",
+      "Source": "https://github.com/aws-cloudformation/cfn-python-lint"
+    }
+  }
+]`
+
+	p := NewCfnLintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Invalid or unsupported Type AWS::S3::Buckett for resource Bucket in us-east-1",
+	//   "location": {
+	//     "path": "template.yaml",
+	//     "range": {
+	//       "start": {
+	//         "line": 5,
+	//         "column": 7
+	//       },
+	//       "end": {
+	//         "line": 5,
+	//         "column": 17
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "E3001",
+	//     "url": "https://github.com/aws-cloudformation/cfn-python-lint"
+	//   },
+	//   "originalOutput": "E3001 Invalid or unsupported Type AWS::S3::Buckett for resource Bucket in us-east-1\ntemplate.yaml:5:7",
+	//   "format": "cfn-lint"
+	// }
+	// {
+	//   "message": "Parameter Env not used.",
+	//   "location": {
+	//     "path": "template.yaml",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 3
+	//       },
+	//       "end": {
+	//         "line": 10,
+	//         "column": 12
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "I2001",
+	//     "url": "https://github.com/aws-cloudformation/cfn-python-lint"
+	//   },
+	//   "originalOutput": "I2001 Parameter Env not used.\ntemplate.yaml:10:3",
+	//   "format": "cfn-lint"
+	// }
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/reviewdog/reviewdog/proto/rdf"
 )
@@ -11,21 +12,60 @@ import (
 var _ Parser = &CheckStyleParser{}
 
 // CheckStyleParser is checkstyle parser.
-type CheckStyleParser struct{}
+type CheckStyleParser struct {
+	mapSeverity func(string) rdf.Severity
+	// dropSeverities is set of lower-cased raw severities to drop.
+	dropSeverities map[string]bool
+	// trimSourcePrefix trims "<source>: " prefix from messages.
+	trimSourcePrefix bool
+	// format defaults to "checkstyle".
+	format string
+	// includeToolExceptions reports <exception> of files as errors.
+	includeToolExceptions bool
+}
 
 // NewCheckStyleParser returns a new CheckStyleParser.
 func NewCheckStyleParser() Parser {
 	return &CheckStyleParser{}
 }
 
+// NewGolangCICheckStyleParser returns a new CheckStyleParser preset for
+// golangci-lint checkstyle output (golangci-lint run --out-format=checkstyle).
+// It maps severities configured in golangci-lint (e.g. "blocker") to
+// ERROR, WARNING or INFO, reports sources (linters) as codes and trims
+// "<linter>: " prefixes from messages.
+func NewGolangCICheckStyleParser() Parser {
+	return newGolangCICheckStyleParser()
+}
+
+func newGolangCICheckStyleParser() *CheckStyleParser {
+	return &CheckStyleParser{
+		mapSeverity:      ParseSeverity,
+		trimSourcePrefix: true,
+		format:           "golangci-lint-checkstyle",
+	}
+}
+
 func (p *CheckStyleParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 	var cs = new(CheckStyleResult)
 	if err := xml.NewDecoder(r).Decode(cs); err != nil {
 		return nil, err
 	}
+	format := p.format
+	if format == "" {
+		format = "checkstyle"
+	}
 	var ds []*rdf.Diagnostic
 	for _, file := range cs.Files {
+		if p.includeToolExceptions {
+			for _, exc := range file.Exceptions {
+				ds = append(ds, checkStyleExceptionDiagnostic(file.Name, exc, format))
+			}
+		}
 		for _, cerr := range file.Errors {
+			if p.dropSeverities[strings.ToLower(cerr.Severity)] {
+				continue
+			}
 			d := &rdf.Diagnostic{
 				Location: &rdf.Location{
 					Path: file.Name,
@@ -37,12 +77,16 @@ func (p *CheckStyleParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 					},
 				},
 				Message:  cerr.Message,
-				Severity: severity(cerr.Severity),
+				Severity: p.severity(cerr.Severity),
+				Format:   format,
 				OriginalOutput: fmt.Sprintf("%v:%d:%d: %v: %v (%v)",
 					file.Name, cerr.Line, cerr.Column, cerr.Severity, cerr.Message, cerr.Source),
 			}
 			if s := cerr.Source; s != "" {
 				d.Code = &rdf.Code{Value: s}
+				if p.trimSourcePrefix {
+					d.Message = strings.TrimPrefix(d.Message, s+": ")
+				}
 			}
 			ds = append(ds, d)
 		}
@@ -50,6 +94,30 @@ func (p *CheckStyleParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 	return ds, nil
 }
 
+func (p *CheckStyleParser) severity(s string) rdf.Severity {
+	if p.mapSeverity != nil {
+		return p.mapSeverity(s)
+	}
+	return severity(s)
+}
+
+// checkStyleExceptionDiagnostic returns a file-level diagnostic of exception
+// (e.g. a stack trace) which the tool hit while analyzing the file.
+func checkStyleExceptionDiagnostic(path, exc, format string) *rdf.Diagnostic {
+	exc = strings.TrimSpace(exc)
+	summary := exc
+	if i := strings.IndexByte(summary, '\n'); i >= 0 {
+		summary = strings.TrimSpace(summary[:i])
+	}
+	return &rdf.Diagnostic{
+		Location:       &rdf.Location{Path: path},
+		Message:        "tool failed to analyze file: " + summary,
+		Severity:       rdf.Severity_ERROR,
+		Format:         format,
+		OriginalOutput: fmt.Sprintf("%s: exception: %s", path, exc),
+	}
+}
+
 // CheckStyleResult represents checkstyle XML result.
 // <?xml version="1.0" encoding="utf-8"?><checkstyle version="4.3"><file ...></file>...</checkstyle>
 //
@@ -63,9 +131,12 @@ type CheckStyleResult struct {
 }
 
 // CheckStyleFile represents <file name="fname"><error ... />...</file>
+// Exceptions are <exception> elements which some generators add when the tool
+// failed to analyze the file.
 type CheckStyleFile struct {
-	Name   string             `xml:"name,attr"`
-	Errors []*CheckStyleError `xml:"error"`
+	Name       string             `xml:"name,attr"`
+	Errors     []*CheckStyleError `xml:"error"`
+	Exceptions []string           `xml:"exception"`
 }
 
 // CheckStyleError represents <error line="1" column="10" severity="error" message="msg" source="src" />
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
 )
 
 func ExampleCheckStyleParser() {
@@ -39,7 +43,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.no-unused-vars"
 	//   },
-	//   "originalOutput": "/path/to/file:1:10: error: 'addOne' is defined but never used. (no-unused-vars) (eslint.rules.no-unused-vars)"
+	//   "originalOutput": "/path/to/file:1:10: error: 'addOne' is defined but never used. (no-unused-vars) (eslint.rules.no-unused-vars)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Use the isNaN function to compare with NaN. (use-isnan)",
@@ -56,7 +61,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.use-isnan"
 	//   },
-	//   "originalOutput": "/path/to/file:2:9: error: Use the isNaN function to compare with NaN. (use-isnan) (eslint.rules.use-isnan)"
+	//   "originalOutput": "/path/to/file:2:9: error: Use the isNaN function to compare with NaN. (use-isnan) (eslint.rules.use-isnan)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Unexpected space before unary operator '++'. (space-unary-ops)",
@@ -73,7 +79,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.space-unary-ops"
 	//   },
-	//   "originalOutput": "/path/to/file:3:16: error: Unexpected space before unary operator '++'. (space-unary-ops) (eslint.rules.space-unary-ops)"
+	//   "originalOutput": "/path/to/file:3:16: error: Unexpected space before unary operator '++'. (space-unary-ops) (eslint.rules.space-unary-ops)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Missing semicolon. (semi)",
@@ -90,7 +97,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.semi"
 	//   },
-	//   "originalOutput": "/path/to/file:3:20: warning: Missing semicolon. (semi) (eslint.rules.semi)"
+	//   "originalOutput": "/path/to/file:3:20: warning: Missing semicolon. (semi) (eslint.rules.semi)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Unnecessary 'else' after 'return'. (no-else-return)",
@@ -107,7 +115,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.no-else-return"
 	//   },
-	//   "originalOutput": "/path/to/file:4:12: warning: Unnecessary 'else' after 'return'. (no-else-return) (eslint.rules.no-else-return)"
+	//   "originalOutput": "/path/to/file:4:12: warning: Unnecessary 'else' after 'return'. (no-else-return) (eslint.rules.no-else-return)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Expected indentation of 8 spaces but found 6. (indent)",
@@ -124,7 +133,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.indent"
 	//   },
-	//   "originalOutput": "/path/to/file:5:7: warning: Expected indentation of 8 spaces but found 6. (indent) (eslint.rules.indent)"
+	//   "originalOutput": "/path/to/file:5:7: warning: Expected indentation of 8 spaces but found 6. (indent) (eslint.rules.indent)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Expected a return value. (consistent-return)",
@@ -141,7 +151,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.consistent-return"
 	//   },
-	//   "originalOutput": "/path/to/file:5:7: error: Expected a return value. (consistent-return) (eslint.rules.consistent-return)"
+	//   "originalOutput": "/path/to/file:5:7: error: Expected a return value. (consistent-return) (eslint.rules.consistent-return)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Missing semicolon. (semi)",
@@ -158,7 +169,8 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.semi"
 	//   },
-	//   "originalOutput": "/path/to/file:5:13: warning: Missing semicolon. (semi) (eslint.rules.semi)"
+	//   "originalOutput": "/path/to/file:5:13: warning: Missing semicolon. (semi) (eslint.rules.semi)",
+	//   "format": "checkstyle"
 	// }
 	// {
 	//   "message": "Unnecessary semicolon. (no-extra-semi)",
@@ -175,6 +187,138 @@ func ExampleCheckStyleParser() {
 	//   "code": {
 	//     "value": "eslint.rules.no-extra-semi"
 	//   },
-	//   "originalOutput": "/path/to/file:7:2: error: Unnecessary semicolon. (no-extra-semi) (eslint.rules.no-extra-semi)"
+	//   "originalOutput": "/path/to/file:7:2: error: Unnecessary semicolon. (no-extra-semi) (eslint.rules.no-extra-semi)",
+	//   "format": "checkstyle"
+	// }
+}
+
+func ExampleNewGolangCICheckStyleParser() {
+	// golangci-lint run --out-format=checkstyle
+	const sample = `<?xml version="1.0" encoding="UTF-8"?>
+
+<checkstyle version="5.0">
+  <file name="main.go">
+    <error column="9" line="15" message="Error return value of ` + "`os.Open`" + ` is not checked" severity="error" source="errcheck"></error>
+    <error column="2" line="13" message="printf: fmt.Sprintf format %d reads arg #1, but call has 0 args" severity="warning" source="govet"></error>
+  </file>
+  <file name="pkg/a.go">
+    <error column="1" line="3" message="revive: exported function Foo should have comment or be unexported" severity="blocker" source="revive"></error>
+  </file>
+</checkstyle>`
+
+	p := NewGolangCICheckStyleParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15:9: error: Error return value of `os.Open` is not checked (errcheck)",
+	//   "format": "golangci-lint-checkstyle"
 	// }
+	// {
+	//   "message": "printf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 13,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "govet"
+	//   },
+	//   "originalOutput": "main.go:13:2: warning: printf: fmt.Sprintf format %d reads arg #1, but call has 0 args (govet)",
+	//   "format": "golangci-lint-checkstyle"
+	// }
+	// {
+	//   "message": "exported function Foo should have comment or be unexported",
+	//   "location": {
+	//     "path": "pkg/a.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "revive"
+	//   },
+	//   "originalOutput": "pkg/a.go:3:1: blocker: revive: exported function Foo should have comment or be unexported (revive)",
+	//   "format": "golangci-lint-checkstyle"
+	// }
+}
+
+func TestCheckStyleParser_includeToolExceptions(t *testing.T) {
+	const sample = `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="8.41">
+<file name="src/main/java/App.java">
+<error line="3" column="1" severity="warning" message="Missing a Javadoc comment." source="com.puppycrawl.tools.checkstyle.checks.javadoc.MissingJavadocTypeCheck"/>
+</file>
+<file name="src/main/java/Broken.java">
+<exception>
+<![CDATA[
+com.puppycrawl.tools.checkstyle.api.CheckstyleException: IllegalStateException occurred while parsing file src/main/java/Broken.java.
+	at com.puppycrawl.tools.checkstyle.Checker.processFiles(Checker.java:311)
+]]>
+</exception>
+</file>
+</checkstyle>`
+	tests := []struct {
+		include bool
+		want    []string
+	}{
+		{include: false, want: []string{"Missing a Javadoc comment."}},
+		{include: true, want: []string{
+			"Missing a Javadoc comment.",
+			"tool failed to analyze file: com.puppycrawl.tools.checkstyle.api.CheckstyleException: IllegalStateException occurred while parsing file src/main/java/Broken.java.",
+		}},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "checkstyle", IncludeToolExceptions: tt.include})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			got = append(got, d.GetMessage())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("IncludeToolExceptions=%v: messages (-want +got):\n%s", tt.include, diff)
+		}
+		if tt.include {
+			if d := ds[1]; d.GetSeverity() != rdf.Severity_ERROR || d.GetLocation().GetPath() != "src/main/java/Broken.java" {
+				t.Errorf("got exception diagnostic %v, want file-level error", d)
+			}
+		}
+	}
 }
@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &CodeClimateParser{}
+
+// CodeClimateParser is parser for Code Climate JSON (array of issues), which
+// is used by GitLab Code Quality and golangci-lint (--out-format=code-climate).
+// https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md#data-types
+type CodeClimateParser struct {
+	preserveRaw bool
+}
+
+// NewCodeClimateParser returns a new CodeClimateParser.
+func NewCodeClimateParser() *CodeClimateParser {
+	return &CodeClimateParser{}
+}
+
+// Parse parses Code Climate JSON.
+func (p *CodeClimateParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var issues []*CodeClimateIssue
+	if err := json.NewDecoder(r).Decode(&issues); err != nil {
+		return nil, fmt.Errorf("failed to decode Code Climate JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, issue := range issues {
+		loc := issue.Location
+		if loc == nil {
+			loc = &CodeClimateLocation{}
+		}
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path:  loc.Path,
+				Range: loc.rdfRange(),
+			},
+			Message:     issue.Description,
+			Severity:    ParseSeverity(issue.Severity),
+			Fingerprint: issue.Fingerprint,
+			Format:      "codeclimate",
+			OriginalOutput: fmt.Sprintf("%s:%d: %s: %s (%s)",
+				loc.Path, loc.rdfRange().GetStart().GetLine(), issue.Severity, issue.Description, issue.CheckName),
+		}
+		if issue.CheckName != "" {
+			d.Code = &rdf.Code{Value: issue.CheckName}
+		}
+		if p.preserveRaw {
+			d.Raw = issue.raw
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// WriteCodeQuality writes diagnostics as GitLab Code Quality JSON, which can
+// be parsed by CodeClimateParser. Fingerprints are computed with opts, which
+// defaults to ExcludeLine as GitLab requires fingerprints stable across line
+// shifts if nil.
+// https://docs.gitlab.com/ee/ci/testing/code_quality.html#implement-a-custom-tool
+func WriteCodeQuality(w io.Writer, ds []*rdf.Diagnostic, opts *FingerprintOptions) error {
+	if opts == nil {
+		opts = &FingerprintOptions{ExcludeLine: true}
+	}
+	issues := make([]*CodeClimateIssue, 0, len(ds))
+	for _, d := range ds {
+		issues = append(issues, &CodeClimateIssue{
+			Description: d.GetMessage(),
+			CheckName:   d.GetCode().GetValue(),
+			Severity:    codeQualitySeverity(d.GetSeverity()),
+			Fingerprint: DiagnosticFingerprint(d, opts),
+			Location: &CodeClimateLocation{
+				Path: d.GetLocation().GetPath(),
+				Lines: &CodeClimateLines{
+					Begin: int(d.GetLocation().GetRange().GetStart().GetLine()),
+				},
+			},
+		})
+	}
+	if err := json.NewEncoder(w).Encode(issues); err != nil {
+		return fmt.Errorf("failed to encode Code Quality JSON: %w", err)
+	}
+	return nil
+}
+
+func codeQualitySeverity(s rdf.Severity) string {
+	switch s {
+	case rdf.Severity_ERROR:
+		return "critical"
+	case rdf.Severity_WARNING:
+		return "minor"
+	default:
+		return "info"
+	}
+}
+
+// CodeClimateIssue represents an issue of Code Climate JSON.
+// {"description":"msg","check_name":"errcheck","severity":"minor","fingerprint":"abc","location":{"path":"main.go","lines":{"begin":1}}}
+type CodeClimateIssue struct {
+	Description string               `json:"description"`
+	CheckName   string               `json:"check_name,omitempty"`
+	Severity    string               `json:"severity"`
+	Fingerprint string               `json:"fingerprint"`
+	Location    *CodeClimateLocation `json:"location"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler to keep the raw JSON.
+func (i *CodeClimateIssue) UnmarshalJSON(b []byte) error {
+	type issue CodeClimateIssue // Avoid recursion.
+	return unmarshalRaw(b, (*issue)(i), &i.raw)
+}
+
+// CodeClimateLocation represents location of an issue. Either Lines or
+// Positions is specified.
+type CodeClimateLocation struct {
+	Path      string                `json:"path"`
+	Lines     *CodeClimateLines     `json:"lines,omitempty"`
+	Positions *CodeClimatePositions `json:"positions,omitempty"`
+}
+
+func (l *CodeClimateLocation) rdfRange() *rdf.Range {
+	switch {
+	case l.Positions != nil:
+		rng := &rdf.Range{Start: l.Positions.Begin.rdfPosition()}
+		if l.Positions.End != nil {
+			rng.End = l.Positions.End.rdfPosition()
+		}
+		return rng
+	case l.Lines != nil:
+		rng := &rdf.Range{Start: &rdf.Position{Line: int32(l.Lines.Begin)}}
+		if l.Lines.End != 0 {
+			rng.End = &rdf.Position{Line: int32(l.Lines.End)}
+		}
+		return rng
+	}
+	return nil
+}
+
+// CodeClimateLines represents line-based location.
+type CodeClimateLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end,omitempty"`
+}
+
+// CodeClimatePositions represents position-based location.
+type CodeClimatePositions struct {
+	Begin *CodeClimatePosition `json:"begin"`
+	End   *CodeClimatePosition `json:"end"`
+}
+
+// CodeClimatePosition represents a position. Column is 1-based.
+type CodeClimatePosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (p *CodeClimatePosition) rdfPosition() *rdf.Position {
+	if p == nil {
+		return nil
+	}
+	return &rdf.Position{Line: int32(p.Line), Column: int32(p.Column)}
+}
@@ -0,0 +1,145 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func ExampleCodeClimateParser() {
+	// golangci-lint run --out-format=code-climate
+	const sample = `[
+  {
+    "description": "errcheck: Error return value of ` + "`os.Open`" + ` is not checked",
+    "check_name": "errcheck",
+    "severity": "major",
+    "fingerprint": "1B55DCCC5A5E3E5F41C0C260A9A6D233",
+    "location": {
+      "path": "main.go",
+      "positions": {
+        "begin": {"line": 15, "column": 9},
+        "end": {"line": 15, "column": 23}
+      }
+    }
+  },
+  {
+    "description": "deadcode: ` + "`unused`" + ` is unused",
+    "check_name": "deadcode",
+    "severity": "minor",
+    "fingerprint": "2C4F6A0F7FCDB2C0D3E1A1A5E0E1D6B2",
+    "location": {
+      "path": "main.go",
+      "lines": {"begin": 18}
+    }
+  }
+]`
+
+	p := NewCodeClimateParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "errcheck: Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       },
+	//       "end": {
+	//         "line": 15,
+	//         "column": 23
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15: major: errcheck: Error return value of `os.Open` is not checked (errcheck)",
+	//   "fingerprint": "1B55DCCC5A5E3E5F41C0C260A9A6D233",
+	//   "format": "codeclimate"
+	// }
+	// {
+	//   "message": "deadcode: `unused` is unused",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 18
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "deadcode"
+	//   },
+	//   "originalOutput": "main.go:18: minor: deadcode: `unused` is unused (deadcode)",
+	//   "fingerprint": "2C4F6A0F7FCDB2C0D3E1A1A5E0E1D6B2",
+	//   "format": "codeclimate"
+	// }
+}
+
+func TestWriteCodeQuality_roundTrip(t *testing.T) {
+	ds := []*rdf.Diagnostic{
+		{
+			Message:     "Error return value is not checked",
+			Location:    &rdf.Location{Path: "main.go", Range: &rdf.Range{Start: &rdf.Position{Line: 15}}},
+			Severity:    rdf.Severity_ERROR,
+			Code:        &rdf.Code{Value: "errcheck"},
+			Fingerprint: "1B55DCCC5A5E3E5F41C0C260A9A6D233",
+		},
+		{
+			Message:  "`unused` is unused",
+			Location: &rdf.Location{Path: "main.go", Range: &rdf.Range{Start: &rdf.Position{Line: 18}}},
+			Severity: rdf.Severity_WARNING,
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteCodeQuality(&buf, ds, nil); err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewCodeClimateParser().Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The fingerprint of a diagnostic without one is filled by the helper.
+	want := proto.Clone(ds[1]).(*rdf.Diagnostic)
+	want.Fingerprint = DiagnosticFingerprint(ds[1], &FingerprintOptions{ExcludeLine: true})
+	if diff := cmp.Diff([]*rdf.Diagnostic{ds[0], want}, got, protocmp.Transform(),
+		protocmp.IgnoreFields(&rdf.Diagnostic{}, "original_output", "format")); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+
+	// Fingerprints are stable across line shifts.
+	ds[1].Location.Range.Start.Line += 3
+	buf.Reset()
+	if err := WriteCodeQuality(&buf, ds, nil); err != nil {
+		t.Fatal(err)
+	}
+	shifted, err := NewCodeClimateParser().Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := shifted[1].GetFingerprint(), want.GetFingerprint(); got != want {
+		t.Errorf("fingerprint after line shift: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &CodeNarcParser{}
+
+// CodeNarcParser is parser for CodeNarc (Groovy) XML report.
+// https://codenarc.github.io/CodeNarc/codenarc-xml-report-writer.html
+type CodeNarcParser struct {
+	mapPriority func(int) rdf.Severity
+}
+
+// NewCodeNarcParser returns a new CodeNarcParser.
+func NewCodeNarcParser() *CodeNarcParser {
+	return &CodeNarcParser{}
+}
+
+// Parse parses CodeNarc XML report. Paths are package path joined with file
+// name.
+func (p *CodeNarcParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var report CodeNarcReport
+	if err := xml.NewDecoder(r).Decode(&report); err != nil {
+		return nil, err
+	}
+	mapPriority := codeNarcSeverity
+	if p.mapPriority != nil {
+		mapPriority = p.mapPriority
+	}
+	var ds []*rdf.Diagnostic
+	for _, pkg := range report.Packages {
+		for _, file := range pkg.Files {
+			fpath := path.Join(pkg.Path, file.Name)
+			for _, v := range file.Violations {
+				msg := strings.TrimSpace(v.Message)
+				d := &rdf.Diagnostic{
+					Location: &rdf.Location{Path: fpath},
+					Message:  msg,
+					Severity: mapPriority(v.Priority),
+					Format:   "codenarc",
+					OriginalOutput: fmt.Sprintf("%s:%d: [%s] P%d: %s",
+						fpath, v.LineNumber, v.RuleName, v.Priority, msg),
+				}
+				if v.LineNumber > 0 {
+					d.Location.Range = &rdf.Range{Start: &rdf.Position{Line: int32(v.LineNumber)}}
+				}
+				if v.RuleName != "" {
+					d.Code = &rdf.Code{Value: v.RuleName}
+				}
+				ds = append(ds, d)
+			}
+		}
+	}
+	return ds, nil
+}
+
+func codeNarcSeverity(priority int) rdf.Severity {
+	switch priority {
+	case 1:
+		return rdf.Severity_ERROR
+	case 2:
+		return rdf.Severity_WARNING
+	case 3:
+		return rdf.Severity_INFO
+	default:
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+}
+
+// CodeNarcReport represents CodeNarc XML report.
+// <CodeNarc><Package path="org/example"><File name="Foo.groovy"><Violation ruleName="UnusedImport" priority="3" lineNumber="2"><Message>msg</Message></Violation></File></Package></CodeNarc>
+type CodeNarcReport struct {
+	XMLName  xml.Name           `xml:"CodeNarc"`
+	Packages []*CodeNarcPackage `xml:"Package"`
+}
+
+// CodeNarcPackage represents a package (directory).
+type CodeNarcPackage struct {
+	Path  string          `xml:"path,attr"`
+	Files []*CodeNarcFile `xml:"File"`
+}
+
+// CodeNarcFile represents a file in a package.
+type CodeNarcFile struct {
+	Name       string               `xml:"name,attr"`
+	Violations []*CodeNarcViolation `xml:"Violation"`
+}
+
+// CodeNarcViolation represents a rule violation. Priority is 1 (high) to 3
+// (low).
+type CodeNarcViolation struct {
+	RuleName   string `xml:"ruleName,attr"`
+	Priority   int    `xml:"priority,attr"`
+	LineNumber int    `xml:"lineNumber,attr"`
+	SourceLine string `xml:"SourceLine"`
+	Message    string `xml:"Message"`
+}
@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleCodeNarcParser() {
+	const sample = `<?xml version="1.0" encoding="UTF-8"?>
+<CodeNarc url="https://www.codenarc.org" version="2.0.0">
+  <Report timestamp="Nov 21, 2020, 10:00:00 AM"/>
+  <Project title="example">
+    <SourceDirectory>src/main/groovy</SourceDirectory>
+  </Project>
+  <PackageSummary totalFiles="2" filesWithViolations="2" priority1="1" priority2="0" priority3="1"/>
+  <Package path="" totalFiles="1" filesWithViolations="1" priority1="0" priority2="0" priority3="1">
+    <File name="Main.groovy">
+      <Violation ruleName="UnusedImport" priority="3" lineNumber="2">
+        <SourceLine><![CDATA[import java.util.Map]]></SourceLine>
+        <Message><![CDATA[The [java.util.Map] import is never referenced]]></Message>
+      </Violation>
+    </File>
+  </Package>
+  <Package path="org/example" totalFiles="1" filesWithViolations="1" priority1="1" priority2="0" priority3="0">
+    <File name="Foo.groovy">
+      <Violation ruleName="EmptyCatchBlock" priority="1" lineNumber="15">
+        <SourceLine><![CDATA[} catch (Exception e) {}]]></SourceLine>
+        <Message><![CDATA[The catch block is empty]]></Message>
+      </Violation>
+    </File>
+  </Package>
+</CodeNarc>`
+
+	p := NewCodeNarcParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "The [java.util.Map] import is never referenced",
+	//   "location": {
+	//     "path": "Main.groovy",
+	//     "range": {
+	//       "start": {
+	//         "line": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "UnusedImport"
+	//   },
+	//   "originalOutput": "Main.groovy:2: [UnusedImport] P3: The [java.util.Map] import is never referenced",
+	//   "format": "codenarc"
+	// }
+	// {
+	//   "message": "The catch block is empty",
+	//   "location": {
+	//     "path": "org/example/Foo.groovy",
+	//     "range": {
+	//       "start": {
+	//         "line": 15
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "EmptyCatchBlock"
+	//   },
+	//   "originalOutput": "org/example/Foo.groovy:15: [EmptyCatchBlock] P1: The catch block is empty",
+	//   "format": "codenarc"
+	// }
+}
@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &CommitlintParser{}
+
+// CommitlintPath is the synthetic path of diagnostics reported by commitlint
+// as commit messages are not files.
+const CommitlintPath = "COMMIT_MSG"
+
+// CommitlintParser is parser for commitlint JSON output.
+// https://github.com/conventional-changelog/commitlint
+type CommitlintParser struct {
+	mapLevel func(int) rdf.Severity
+}
+
+// NewCommitlintParser returns a new CommitlintParser.
+func NewCommitlintParser() *CommitlintParser {
+	return &CommitlintParser{}
+}
+
+// Parse parses commitlint JSON output.
+func (p *CommitlintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var report CommitlintReport
+	if err := json.NewDecoder(r).Decode(&report); err != nil {
+		return nil, fmt.Errorf("failed to decode commitlint JSON: %w", err)
+	}
+	mapLevel := commitlintSeverity
+	if p.mapLevel != nil {
+		mapLevel = p.mapLevel
+	}
+	var ds []*rdf.Diagnostic
+	for _, result := range report.Results {
+		for _, problems := range [][]*CommitlintProblem{result.Errors, result.Warnings} {
+			for _, problem := range problems {
+				d := &rdf.Diagnostic{
+					Location:       &rdf.Location{Path: CommitlintPath},
+					Message:        problem.Message,
+					Severity:       mapLevel(problem.Level),
+					Format:         "commitlint",
+					OriginalOutput: fmt.Sprintf("%s [%s]", problem.Message, problem.Name),
+				}
+				if problem.Name != "" {
+					d.Code = &rdf.Code{Value: problem.Name}
+				}
+				ds = append(ds, d)
+			}
+		}
+	}
+	return ds, nil
+}
+
+func commitlintSeverity(level int) rdf.Severity {
+	switch level {
+	case 2:
+		return rdf.Severity_ERROR
+	case 1:
+		return rdf.Severity_WARNING
+	default:
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+}
+
+// CommitlintReport represents commitlint JSON output.
+// {"valid":false,"errorCount":1,"warningCount":0,"results":[{"valid":false,"input":"foo","errors":[{"level":2,"valid":false,"name":"type-empty","message":"type may not be empty"}],"warnings":[]}]}
+type CommitlintReport struct {
+	Results []*CommitlintResult `json:"results"`
+}
+
+// CommitlintResult represents the result of a commit message.
+type CommitlintResult struct {
+	Input    string               `json:"input"`
+	Errors   []*CommitlintProblem `json:"errors"`
+	Warnings []*CommitlintProblem `json:"warnings"`
+}
+
+// CommitlintProblem represents a rule violation. Level is 1 for warnings and
+// 2 for errors.
+type CommitlintProblem struct {
+	Level   int    `json:"level"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleCommitlintParser() {
+	// commitlint --format commitlint-format-json
+	const sample = `{
+  "valid": false,
+  "errorCount": 2,
+  "warningCount": 1,
+  "results": [
+    {
+      "valid": false,
+      "input": "foo: bar",
+      "errors": [
+        {"level": 2, "valid": false, "name": "type-enum", "message": "type must be one of [build, chore, ci, docs, feat, fix]"}
+      ],
+      "warnings": [
+        {"level": 1, "valid": false, "name": "body-leading-blank", "message": "body must have leading blank line"}
+      ]
+    },
+    {
+      "valid": false,
+      "input": "fix:",
+      "errors": [
+        {"level": 2, "valid": false, "name": "subject-empty", "message": "subject may not be empty"}
+      ],
+      "warnings": []
+    }
+  ]
+}`
+
+	p := NewCommitlintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "type must be one of [build, chore, ci, docs, feat, fix]",
+	//   "location": {
+	//     "path": "COMMIT_MSG"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "type-enum"
+	//   },
+	//   "originalOutput": "type must be one of [build, chore, ci, docs, feat, fix] [type-enum]",
+	//   "format": "commitlint"
+	// }
+	// {
+	//   "message": "body must have leading blank line",
+	//   "location": {
+	//     "path": "COMMIT_MSG"
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "body-leading-blank"
+	//   },
+	//   "originalOutput": "body must have leading blank line [body-leading-blank]",
+	//   "format": "commitlint"
+	// }
+	// {
+	//   "message": "subject may not be empty",
+	//   "location": {
+	//     "path": "COMMIT_MSG"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "subject-empty"
+	//   },
+	//   "originalOutput": "subject may not be empty [subject-empty]",
+	//   "format": "commitlint"
+	// }
+}
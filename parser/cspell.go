@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &CSpellParser{}
+
+// CSpellParser is parser for cspell JSON output (cspell --reporter json).
+// https://github.com/streetsidesoftware/cspell
+type CSpellParser struct{}
+
+// NewCSpellParser returns a new CSpellParser.
+func NewCSpellParser() *CSpellParser {
+	return &CSpellParser{}
+}
+
+// Parse parses cspell JSON output.
+func (p *CSpellParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result CSpellResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode cspell JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, issue := range result.Issues {
+		path := cspellPath(issue.URI)
+		msg := issue.Message
+		if msg == "" {
+			msg = "Unknown word"
+		}
+		msg = fmt.Sprintf("%s: %s", msg, issue.Text)
+		if len(issue.Suggestions) > 0 {
+			msg += fmt.Sprintf(" (suggestions: %s)", strings.Join(issue.Suggestions, ", "))
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: path,
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(issue.Row),
+						Column: int32(issue.Col),
+					},
+				},
+			},
+			Message:        msg,
+			Severity:       rdf.Severity_INFO,
+			Format:         "cspell",
+			OriginalOutput: fmt.Sprintf("%s:%d:%d - %s", path, issue.Row, issue.Col, msg),
+		})
+	}
+	return ds, nil
+}
+
+// cspellPath converts file URI reported by cspell to file path.
+func cspellPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return u.Path
+}
+
+// CSpellResult represents cspell JSON result.
+// {"issues":[{"text":"teh","uri":"file:///path/to/file","row":1,"col":5,"message":"Unknown word","suggestions":["the"]}]}
+type CSpellResult struct {
+	Issues []*CSpellIssue `json:"issues"`
+}
+
+// CSpellIssue represents a misspelling reported by cspell.
+type CSpellIssue struct {
+	Text        string   `json:"text"`
+	URI         string   `json:"uri"`
+	Row         int      `json:"row"`
+	Col         int      `json:"col"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions"`
+}
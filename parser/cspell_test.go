@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleCSpellParser() {
+	const sample = `{
+  "issues": [
+    {
+      "text": "teh",
+      "offset": 8,
+      "line": {"text": "This is teh README.\n", "offset": 0},
+      "row": 1,
+      "col": 9,
+      "uri": "file:///home/user/project/README.md",
+      "context": {"text": "This is teh README", "offset": 0},
+      "message": "Unknown word",
+      "suggestions": ["the", "ten", "tea"]
+    },
+    {
+      "text": "recieve",
+      "row": 12,
+      "col": 3,
+      "uri": "docs/guide.md"
+    }
+  ],
+  "result": {"files": 2, "filesWithIssues": [], "issues": 2, "errors": 0}
+}`
+
+	p := NewCSpellParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Unknown word: teh (suggestions: the, ten, tea)",
+	//   "location": {
+	//     "path": "/home/user/project/README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "/home/user/project/README.md:1:9 - Unknown word: teh (suggestions: the, ten, tea)",
+	//   "format": "cspell"
+	// }
+	// {
+	//   "message": "Unknown word: recieve",
+	//   "location": {
+	//     "path": "docs/guide.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "docs/guide.md:12:3 - Unknown word: recieve",
+	//   "format": "cspell"
+	// }
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &DashSeparatedParser{}
+
+// DefaultDashSeparator is the default separator of DashSeparatedParser.
+const DefaultDashSeparator = " - "
+
+// DashSeparatedParser is parser for output of tools which separate positions
+// and messages with a separator other than ": ".
+//
+//	path:line:col - message
+//	path:line - message
+type DashSeparatedParser struct {
+	re *regexp.Regexp
+}
+
+// NewDashSeparatedParser returns a new DashSeparatedParser with the separator.
+// DefaultDashSeparator is used if sep is empty.
+func NewDashSeparatedParser(sep string) *DashSeparatedParser {
+	if sep == "" {
+		sep = DefaultDashSeparator
+	}
+	return &DashSeparatedParser{
+		re: regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?` + regexp.QuoteMeta(sep) + `(.*)$`),
+	}
+}
+
+// Parse parses separated output. Lines in other formats are ignored.
+func (p *DashSeparatedParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := p.re.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[4],
+			Format:         "dash-separated",
+			OriginalOutput: line,
+		})
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func ExampleDashSeparatedParser() {
+	const sample = `pkgs/foo/default.nix:12:3 - Unnecessary use of rec
+pkgs/bar/default.nix:4 - Assignment instead of inherit
+evaluating 2 files...`
+
+	p := NewDashSeparatedParser("")
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Unnecessary use of rec",
+	//   "location": {
+	//     "path": "pkgs/foo/default.nix",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 3
+	//       }
+	//     }
+	//   },
+	//   "originalOutput": "pkgs/foo/default.nix:12:3 - Unnecessary use of rec",
+	//   "format": "dash-separated"
+	// }
+	// {
+	//   "message": "Assignment instead of inherit",
+	//   "location": {
+	//     "path": "pkgs/bar/default.nix",
+	//     "range": {
+	//       "start": {
+	//         "line": 4
+	//       }
+	//     }
+	//   },
+	//   "originalOutput": "pkgs/bar/default.nix:4 - Assignment instead of inherit",
+	//   "format": "dash-separated"
+	// }
+}
+
+func TestDashSeparatedParser_separator(t *testing.T) {
+	const sample = `src/app.ts:3:14 | Unexpected any
+src/app.ts:5:1 - not separated by the separator`
+	ds, err := NewDashSeparatedParser(" | ").Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Diagnostic{
+		{
+			Message:        "Unexpected any",
+			Location:       &rdf.Location{Path: "src/app.ts", Range: &rdf.Range{Start: &rdf.Position{Line: 3, Column: 14}}},
+			Format:         "dash-separated",
+			OriginalOutput: "src/app.ts:3:14 | Unexpected any",
+		},
+	}
+	if diff := cmp.Diff(want, ds, protocmp.Transform()); diff != "" {
+		t.Errorf("diagnostics (-want +got):\n%s", diff)
+	}
+}
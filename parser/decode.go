@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// DecodeWrappers returns the innermost stream of r, which is decoded from
+// base64 if Option.Base64Input is true, then decompressed if it's detected as
+// gzip or zstd by magic numbers. Other input is returned as is. The returned
+// reader must be closed to release decoders, which doesn't close r.
+func DecodeWrappers(r io.Reader, opt *Option) (io.ReadCloser, error) {
+	if opt.Base64Input {
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip input: %w", err)
+		}
+		return zr, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read zstd input: %w", err)
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return ioutil.NopCloser(br), nil
+	}
+}
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestDecodeWrappers(t *testing.T) {
+	const content = `{"message":"msg","location":{"path":"a.go"}}` + "\n"
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	io.WriteString(gw, content)
+	gw.Close()
+	var zs bytes.Buffer
+	zw, err := zstd.NewWriter(&zs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(zw, content)
+	zw.Close()
+
+	tests := []struct {
+		name  string
+		input []byte
+		opt   *Option
+	}{
+		{name: "plain", input: []byte(content), opt: &Option{}},
+		{name: "gzip", input: gz.Bytes(), opt: &Option{}},
+		{name: "zstd", input: zs.Bytes(), opt: &Option{}},
+		{name: "base64 gzip", input: []byte(base64.StdEncoding.EncodeToString(gz.Bytes())), opt: &Option{Base64Input: true}},
+		{name: "short plain", input: []byte("a"), opt: &Option{}},
+	}
+	for _, tt := range tests {
+		rc, err := DecodeWrappers(bytes.NewReader(tt.input), tt.opt)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		want := content
+		if tt.name == "short plain" {
+			want = "a"
+		}
+		if string(got) != want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, want)
+		}
+	}
+
+	// The same entry point is used by parsers with DecodeInput.
+	for _, input := range [][]byte{[]byte(content), gz.Bytes(), zs.Bytes()} {
+		p, err := New(&Option{FormatName: "rdjsonl", DecodeInput: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(bytes.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != 1 || ds[0].GetMessage() != "msg" {
+			t.Errorf("got %v, want a diagnostic", ds)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &DenoLintParser{}
+
+// DenoLintParser is parser for deno lint JSON output (deno lint --json).
+// https://docs.deno.com/runtime/reference/cli/linter/
+type DenoLintParser struct{}
+
+// NewDenoLintParser returns a new DenoLintParser.
+func NewDenoLintParser() *DenoLintParser {
+	return &DenoLintParser{}
+}
+
+// Parse parses deno lint JSON output. Hints are appended to messages.
+func (p *DenoLintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result DenoLintResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode deno lint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, diag := range result.Diagnostics {
+		path := diag.Filename
+		if path == "" && diag.Location != nil {
+			path = diag.Location.Filename
+		}
+		msg := diag.Message
+		if diag.Hint != "" {
+			msg += "\n" + diag.Hint
+		}
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{Path: path},
+			Message:  msg,
+			// deno lint reports all diagnostics as errors.
+			Severity: rdf.Severity_ERROR,
+			Format:   "deno-lint",
+		}
+		if diag.Range != nil && diag.Range.Start != nil {
+			d.Location.Range = &rdf.Range{
+				Start: diag.Range.Start.rdfPosition(),
+				End:   diag.Range.End.rdfPosition(),
+			}
+		} else if diag.Location != nil && diag.Location.Line > 0 {
+			d.Location.Range = &rdf.Range{
+				Start: &rdf.Position{Line: int32(diag.Location.Line), Column: int32(diag.Location.Col)},
+			}
+		}
+		if diag.Code != "" {
+			d.Code = &rdf.Code{Value: diag.Code, Url: "https://docs.deno.com/lint/rules/" + diag.Code}
+		}
+		start := d.GetLocation().GetRange().GetStart()
+		d.OriginalOutput = fmt.Sprintf("%s:%d:%d: error[%s]: %s", path, start.GetLine(), start.GetColumn(), diag.Code, diag.Message)
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// DenoLintResult represents deno lint JSON output.
+// {"diagnostics":[{"filename":"main.ts","range":{"start":{"line":1,"col":7},"end":{"line":1,"col":10}},"message":"`foo` is never used","code":"no-unused-vars","hint":"If this is intentional, prefix it with an underscore like `_foo`"}],"errors":[]}
+type DenoLintResult struct {
+	Diagnostics []*DenoLintDiagnostic `json:"diagnostics"`
+}
+
+// DenoLintDiagnostic represents a diagnostic of deno lint. Older versions
+// report the path and start position in Location.
+type DenoLintDiagnostic struct {
+	Filename string            `json:"filename"`
+	Location *DenoLintLocation `json:"location"`
+	Range    *DenoLintRange    `json:"range"`
+	Message  string            `json:"message"`
+	Code     string            `json:"code"`
+	Hint     string            `json:"hint"`
+}
+
+// DenoLintLocation represents a start location of a diagnostic.
+type DenoLintLocation struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Col      int    `json:"col"`
+}
+
+// DenoLintRange represents a range of a diagnostic.
+type DenoLintRange struct {
+	Start *DenoLintPosition `json:"start"`
+	End   *DenoLintPosition `json:"end"`
+}
+
+// DenoLintPosition represents a position. Lines and columns are 1-based.
+type DenoLintPosition struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
+}
+
+func (p *DenoLintPosition) rdfPosition() *rdf.Position {
+	if p == nil {
+		return nil
+	}
+	return &rdf.Position{Line: int32(p.Line), Column: int32(p.Col)}
+}
@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleDenoLintParser() {
+	// deno lint --json
+	const sample = `{
+  "diagnostics": [
+    {
+      "range": {
+        "start": {"line": 1, "col": 7, "bytePos": 6},
+        "end": {"line": 1, "col": 10, "bytePos": 9}
+      },
+      "filename": "src/main.ts",
+      "message": "` + "`foo`" + ` is never used",
+      "code": "no-unused-vars",
+      "hint": "If this is intentional, prefix it with an underscore like ` + "`_foo`" + `"
+    },
+    {
+      "location": {"filename": "src/util.ts", "line": 3, "col": 1},
+      "message": "Empty block statement",
+      "code": "no-empty"
+    }
+  ],
+  "errors": []
+}`
+
+	p := NewDenoLintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "`foo` is never used\nIf this is intentional, prefix it with an underscore like `_foo`",
+	//   "location": {
+	//     "path": "src/main.ts",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 7
+	//       },
+	//       "end": {
+	//         "line": 1,
+	//         "column": 10
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "no-unused-vars",
+	//     "url": "https://docs.deno.com/lint/rules/no-unused-vars"
+	//   },
+	//   "originalOutput": "src/main.ts:1:7: error[no-unused-vars]: `foo` is never used",
+	//   "format": "deno-lint"
+	// }
+	// {
+	//   "message": "Empty block statement",
+	//   "location": {
+	//     "path": "src/util.ts",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "no-empty",
+	//     "url": "https://docs.deno.com/lint/rules/no-empty"
+	//   },
+	//   "originalOutput": "src/util.ts:3:1: error[no-empty]: Empty block statement",
+	//   "format": "deno-lint"
+	// }
+}
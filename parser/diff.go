@@ -45,6 +45,7 @@ func (d dstate) build(path string, currentLine int) *rdf.Diagnostic {
 	return &rdf.Diagnostic{
 		Location:       &rdf.Location{Path: path, Range: drange},
 		Suggestions:    []*rdf.Suggestion{{Range: drange, Text: text}},
+		Format:         "diff",
 		OriginalOutput: strings.Join(d.originalLines, "\n"),
 	}
 }
@@ -76,7 +76,8 @@ func ExampleDiffParser() {
 	//       "text": "func fmt() {"
 	//     }
 	//   ],
-	//   "originalOutput": "gofmt.go:3:-func    fmt     () {\ngofmt.go:3:+func fmt() {"
+	//   "originalOutput": "gofmt.go:3:-func    fmt     () {\ngofmt.go:3:+func fmt() {",
+	//   "format": "diff"
 	// }
 	// {
 	//   "location": {
@@ -103,7 +104,8 @@ func ExampleDiffParser() {
 	//       "text": "\tprintln(\n\t\t\"hello, gofmt test\")\n\t//comment"
 	//     }
 	//   ],
-	//   "originalOutput": "gofmt.go:13:-println(\ngofmt.go:14:-\t\t\"hello, gofmt test\"    )\ngofmt.go:15:-//comment\ngofmt.go:13:+\tprintln(\ngofmt.go:14:+\t\t\"hello, gofmt test\")\ngofmt.go:15:+\t//comment"
+	//   "originalOutput": "gofmt.go:13:-println(\ngofmt.go:14:-\t\t\"hello, gofmt test\"    )\ngofmt.go:15:-//comment\ngofmt.go:13:+\tprintln(\ngofmt.go:14:+\t\t\"hello, gofmt test\")\ngofmt.go:15:+\t//comment",
+	//   "format": "diff"
 	// }
 	// {
 	//   "location": {
@@ -134,7 +136,8 @@ func ExampleDiffParser() {
 	//       "text": "type s struct{ A int }\n"
 	//     }
 	//   ],
-	//   "originalOutput": "gofmt.go:18:+type s struct{ A int }"
+	//   "originalOutput": "gofmt.go:18:+type s struct{ A int }",
+	//   "format": "diff"
 	// }
 	// {
 	//   "location": {
@@ -160,6 +163,93 @@ func ExampleDiffParser() {
 	//       }
 	//     }
 	//   ],
-	//   "originalOutput": "gofmt.go:19:-type s struct { A int }"
+	//   "originalOutput": "gofmt.go:19:-type s struct { A int }",
+	//   "format": "diff"
+	// }
+}
+
+func ExampleDiffParser_nix() {
+	// Diff of Nix formatters (e.g. nixpkgs-fmt, alejandra) in check mode.
+	const sample = `--- a/default.nix
++++ b/default.nix
+@@ -1,6 +1,8 @@
+-{ pkgs ? import <nixpkgs> {} }:
++{pkgs ? import <nixpkgs> {}}:
+ pkgs.mkShell {
+-  buildInputs = [ pkgs.go pkgs.gopls ];
++  buildInputs = [pkgs.go pkgs.gopls];
++
+   shellHook = ''
+     export GOPATH=$PWD/.go
+   '';
+`
+	const strip = 1
+	p := NewDiffParser(strip)
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "location": {
+	//     "path": "default.nix",
+	//     "range": {
+	//       "start": {
+	//         "line": 1
+	//       },
+	//       "end": {
+	//         "line": 1
+	//       }
+	//     }
+	//   },
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 1
+	//         },
+	//         "end": {
+	//           "line": 1
+	//         }
+	//       },
+	//       "text": "{pkgs ? import <nixpkgs> {}}:"
+	//     }
+	//   ],
+	//   "originalOutput": "default.nix:1:-{ pkgs ? import <nixpkgs> {} }:\ndefault.nix:1:+{pkgs ? import <nixpkgs> {}}:",
+	//   "format": "diff"
+	// }
+	// {
+	//   "location": {
+	//     "path": "default.nix",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       },
+	//       "end": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 3
+	//         },
+	//         "end": {
+	//           "line": 3
+	//         }
+	//       },
+	//       "text": "  buildInputs = [pkgs.go pkgs.gopls];\n"
+	//     }
+	//   ],
+	//   "originalOutput": "default.nix:3:-  buildInputs = [ pkgs.go pkgs.gopls ];\ndefault.nix:3:+  buildInputs = [pkgs.go pkgs.gopls];\ndefault.nix:4:+",
+	//   "format": "diff"
 	// }
 }
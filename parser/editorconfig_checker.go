@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &EditorconfigCheckerParser{}
+
+// EditorconfigCheckerParser is parser for editorconfig-checker (ec) output,
+// which groups errors per file:
+//
+//	path:
+//		line: message
+//		message
+//
+// Errors without line number are reported as file-level diagnostics.
+// https://github.com/editorconfig-checker/editorconfig-checker
+type EditorconfigCheckerParser struct{}
+
+// NewEditorconfigCheckerParser returns a new EditorconfigCheckerParser.
+func NewEditorconfigCheckerParser() *EditorconfigCheckerParser {
+	return &EditorconfigCheckerParser{}
+}
+
+// [line: ]message
+var editorconfigCheckerErrRe = regexp.MustCompile(`^(?:(\d+): )?(.+)$`)
+
+// Parse parses editorconfig-checker output. Indented lines before the first
+// file header and other lines (e.g. summary) are ignored.
+func (p *EditorconfigCheckerParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	var path string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		text := s.Text()
+		if !strings.HasPrefix(text, "\t") && !strings.HasPrefix(text, " ") {
+			// File header (a bare path), or summary line which resets it.
+			path = ""
+			if strings.HasSuffix(text, ":") {
+				path = strings.TrimSuffix(text, ":")
+			}
+			continue
+		}
+		m := editorconfigCheckerErrRe.FindStringSubmatch(strings.TrimSpace(text))
+		if path == "" || m == nil {
+			continue
+		}
+		loc := &rdf.Location{Path: path}
+		if m[1] != "" {
+			lnum, _ := strconv.Atoi(m[1])
+			loc.Range = &rdf.Range{Start: &rdf.Position{Line: int32(lnum)}}
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location:       loc,
+			Message:        m[2],
+			Severity:       rdf.Severity_INFO,
+			Format:         "editorconfig-checker",
+			OriginalOutput: path + ": " + strings.TrimSpace(text),
+		})
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleEditorconfigCheckerParser() {
+	const sample = "README.md:\n" +
+		"\t3: Wrong amount of left-padding spaces(want multiple of 2)\n" +
+		"\t8: Trailing whitespace\n" +
+		"src/main.c:\n" +
+		"\tWrong line endings or new final newline\n" +
+		"\n" +
+		"3 errors found\n"
+
+	p := NewEditorconfigCheckerParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Wrong amount of left-padding spaces(want multiple of 2)",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "README.md: 3: Wrong amount of left-padding spaces(want multiple of 2)",
+	//   "format": "editorconfig-checker"
+	// }
+	// {
+	//   "message": "Trailing whitespace",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 8
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "README.md: 8: Trailing whitespace",
+	//   "format": "editorconfig-checker"
+	// }
+	// {
+	//   "message": "Wrong line endings or new final newline",
+	//   "location": {
+	//     "path": "src/main.c"
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "src/main.c: Wrong line endings or new final newline",
+	//   "format": "editorconfig-checker"
+	// }
+}
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &ErrcheckParser{}
+
+// errcheckLineRe matches errcheck output lines, whose unchecked call follows
+// a tab.
+var errcheckLineRe = regexp.MustCompile(`^(.+?):(\d+):(\d+):\t(.*)$`)
+
+// ErrcheckParser is parser for errcheck default output.
+//
+//	path:line:col:	call
+//
+// https://github.com/kisielk/errcheck
+type ErrcheckParser struct{}
+
+// NewErrcheckParser returns a new ErrcheckParser.
+func NewErrcheckParser() *ErrcheckParser {
+	return &ErrcheckParser{}
+}
+
+// Parse parses errcheck output. The unchecked call expression is used as the
+// message. Lines which don't look like diagnostics are ignored.
+func (p *ErrcheckParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := errcheckLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[4],
+			Severity:       rdf.Severity_WARNING,
+			Code:           &rdf.Code{Value: "errcheck"},
+			Format:         "errcheck",
+			OriginalOutput: line,
+		})
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleErrcheckParser() {
+	// errcheck ./...
+	const sample = "main.go:15:12:\tdefer f.Close()\n" +
+		"internal/store/store.go:42:2:\tos.Remove(path)\n" +
+		"error: failed to check packages: exit status 1"
+
+	p := NewErrcheckParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "defer f.Close()",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 12
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15:12:\tdefer f.Close()",
+	//   "format": "errcheck"
+	// }
+	// {
+	//   "message": "os.Remove(path)",
+	//   "location": {
+	//     "path": "internal/store/store.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 42,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "internal/store/store.go:42:2:\tos.Remove(path)",
+	//   "format": "errcheck"
+	// }
+}
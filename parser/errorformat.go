@@ -15,11 +15,13 @@ var _ Parser = &ErrorformatParser{}
 // ErrorformatParser is errorformat parser.
 type ErrorformatParser struct {
 	efm *errorformat.Errorformat
+	// format is the format name set in diagnostics.
+	format string
 }
 
 // NewErrorformatParser returns a new ErrorformatParser.
 func NewErrorformatParser(efm *errorformat.Errorformat) *ErrorformatParser {
-	return &ErrorformatParser{efm: efm}
+	return &ErrorformatParser{efm: efm, format: "errorformat"}
 }
 
 // NewErrorformatParserString returns a new ErrorformatParser from errorformat
@@ -50,6 +52,7 @@ func (p *ErrorformatParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 				},
 				Message:        e.Text,
 				Severity:       severity(string(e.Type)),
+				Format:         p.format,
 				OriginalOutput: strings.Join(e.Lines, "\n"),
 			}
 			if e.Nr != 0 {
@@ -56,7 +56,8 @@ func ExampleErrorformatParser() {
 	//   "code": {
 	//     "value": "14"
 	//   },
-	//   "originalOutput": "/path/to/file1.txt:1:14: [E][RULE:14] message 1"
+	//   "originalOutput": "/path/to/file1.txt:1:14: [E][RULE:14] message 1",
+	//   "format": "errorformat"
 	// }
 	// {
 	//   "message": "message 2",
@@ -73,6 +74,7 @@ func ExampleErrorformatParser() {
 	//   "code": {
 	//     "value": "7"
 	//   },
-	//   "originalOutput": "/path/to/file2.txt:2:14: [N][RULE:7] message 2"
+	//   "originalOutput": "/path/to/file2.txt:2:14: [N][RULE:7] message 2",
+	//   "format": "errorformat"
 	// }
 }
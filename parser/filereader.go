@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// FileReader reads files which diagnostics refer to. Some parsers and options
+// use it to complement information which tools don't report (e.g. line
+// numbers).
+type FileReader interface {
+	ReadFile(path string) ([]byte, error)
+}
+
+// OSFileReader is FileReader which reads files from the local filesystem.
+type OSFileReader struct{}
+
+// ReadFile reads the named file.
+func (OSFileReader) ReadFile(path string) ([]byte, error) {
+	return ioutil.ReadFile(path)
+}
+
+// FileStater is implemented by FileReaders which can report information
+// (e.g. modification time) of files.
+type FileStater interface {
+	Stat(path string) (os.FileInfo, error)
+}
+
+// Stat returns file info of the named file.
+func (OSFileReader) Stat(path string) (os.FileInfo, error) {
+	return os.Stat(path)
+}
+
+// lineCache caches lines of files read with FileReader.
+type lineCache struct {
+	fr    FileReader
+	files map[string][]string
+}
+
+func newLineCache(fr FileReader) *lineCache {
+	return &lineCache{fr: fr, files: make(map[string][]string)}
+}
+
+// lines returns lines of the file. Line endings are removed except for "\r"
+// of "\r\n". It returns false if the file is unreadable or FileReader is nil.
+func (c *lineCache) lines(path string) ([]string, bool) {
+	if c.fr == nil {
+		return nil, false
+	}
+	lines, ok := c.files[path]
+	if !ok {
+		if b, err := c.fr.ReadFile(path); err == nil {
+			lines = strings.Split(string(b), "\n")
+		}
+		c.files[path] = lines
+	}
+	return lines, lines != nil
+}
+
+// line returns the line (1-based) of the file.
+func (c *lineCache) line(path string, lnum int) (string, bool) {
+	lines, ok := c.lines(path)
+	if !ok || lnum < 1 || lnum > len(lines) {
+		return "", false
+	}
+	return lines[lnum-1], true
+}
@@ -0,0 +1,30 @@
+package parser
+
+import (
+	"os"
+	"testing"
+)
+
+// fakeFileReader is FileReader which serves files from memory.
+type fakeFileReader map[string]string
+
+func (f fakeFileReader) ReadFile(path string) ([]byte, error) {
+	s, ok := f[path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return []byte(s), nil
+}
+
+func TestOSFileReader(t *testing.T) {
+	b, err := OSFileReader{}.ReadFile("filereader.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b) == 0 {
+		t.Error("got empty content")
+	}
+	if _, err := (OSFileReader{}).ReadFile("not_found"); !os.IsNotExist(err) {
+		t.Errorf("got %v, want not exist error", err)
+	}
+}
@@ -0,0 +1,108 @@
+package parser
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+// FingerprintOptions configures DiagnosticFingerprint.
+type FingerprintOptions struct {
+	// ExcludeLine excludes the start line from fingerprints so that they are
+	// stable across line shifts, as GitLab requires.
+	ExcludeLine bool
+	// FileReader reads the source line at the start line, whose trimmed
+	// content is hashed instead of the line number if ExcludeLine is true.
+	// Optional.
+	FileReader FileReader
+}
+
+// DiagnosticFingerprint returns a fingerprint which identifies the given
+// diagnostic. It returns the fingerprint reported by the tool if any,
+// otherwise it returns a hash of the path, start line (or source snippet with
+// ExcludeLine), code and message. opts may be nil.
+func DiagnosticFingerprint(d *rdf.Diagnostic, opts *FingerprintOptions) string {
+	if d.GetFingerprint() != "" {
+		return d.GetFingerprint()
+	}
+	if opts == nil {
+		opts = &FingerprintOptions{}
+	}
+	h := sha256.New()
+	if opts.ExcludeLine {
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s",
+			d.GetLocation().GetPath(),
+			sourceSnippet(opts.FileReader, d),
+			d.GetCode().GetValue(),
+			d.GetMessage())
+	} else {
+		fmt.Fprintf(h, "%s\x00%d\x00%s\x00%s",
+			d.GetLocation().GetPath(),
+			d.GetLocation().GetRange().GetStart().GetLine(),
+			d.GetCode().GetValue(),
+			d.GetMessage())
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sourceSnippet returns the trimmed source line at the start line of d, or
+// an empty string if it's unavailable.
+func sourceSnippet(fr FileReader, d *rdf.Diagnostic) string {
+	lnum := int(d.GetLocation().GetRange().GetStart().GetLine())
+	if fr == nil || lnum < 1 {
+		return ""
+	}
+	b, err := fr.ReadFile(d.GetLocation().GetPath())
+	if err != nil {
+		return ""
+	}
+	lines := bytes.Split(b, []byte("\n"))
+	if lnum > len(lines) {
+		return ""
+	}
+	return string(bytes.TrimSpace(lines[lnum-1]))
+}
+
+// FingerprintIndexEntry is minimal info of a diagnostic in a fingerprint
+// index written by WriteFingerprintIndex.
+// {"path":"a.go","line":1,"code":"unused","severity":"WARNING","message":"msg"}
+type FingerprintIndexEntry struct {
+	Path     string `json:"path"`
+	Line     int32  `json:"line,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	Message  string `json:"message"`
+}
+
+// WriteFingerprintIndex writes a JSON object which maps fingerprints (see
+// DiagnosticFingerprint) of the results to their minimal info, so that the
+// next run can load seen fingerprints (see Option.SeenFingerprints) from it.
+// Later results with the same fingerprint are ignored.
+func WriteFingerprintIndex(w io.Writer, results []*rdf.Diagnostic) error {
+	index := make(map[string]FingerprintIndexEntry, len(results))
+	for _, d := range results {
+		fp := DiagnosticFingerprint(d, nil)
+		if _, ok := index[fp]; ok {
+			continue
+		}
+		e := FingerprintIndexEntry{
+			Path:    d.GetLocation().GetPath(),
+			Line:    d.GetLocation().GetRange().GetStart().GetLine(),
+			Code:    d.GetCode().GetValue(),
+			Message: d.GetMessage(),
+		}
+		if d.GetSeverity() != rdf.Severity_UNKNOWN_SEVERITY {
+			e.Severity = d.GetSeverity().String()
+		}
+		index[fp] = e
+	}
+	if err := json.NewEncoder(w).Encode(index); err != nil {
+		return fmt.Errorf("failed to encode fingerprint index JSON: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package parser
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func TestDiagnosticFingerprint(t *testing.T) {
+	d := &rdf.Diagnostic{
+		Message:  "msg",
+		Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 1}}},
+	}
+	fp := DiagnosticFingerprint(d, nil)
+	if fp == "" {
+		t.Fatal("got empty fingerprint")
+	}
+	if got := DiagnosticFingerprint(d, nil); got != fp {
+		t.Errorf("fingerprint is not stable: %q != %q", got, fp)
+	}
+	other := &rdf.Diagnostic{
+		Message:  "msg",
+		Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 2}}},
+	}
+	if got := DiagnosticFingerprint(other, nil); got == fp {
+		t.Errorf("different diagnostics have the same fingerprint %q", got)
+	}
+	d.Fingerprint = "tool-fingerprint"
+	if got := DiagnosticFingerprint(d, nil); got != "tool-fingerprint" {
+		t.Errorf("got %q, want the reported fingerprint", got)
+	}
+}
+
+func TestDiagnosticFingerprint_excludeLine(t *testing.T) {
+	at := func(line int32) *rdf.Diagnostic {
+		return &rdf.Diagnostic{
+			Message:  "unused variable",
+			Code:     &rdf.Code{Value: "unused"},
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: line}}},
+		}
+	}
+	before := fakeFileReader{"a.go": "package a\n\nvar x = 1\n"}
+	after := fakeFileReader{"a.go": "package a\n\n// x is unused.\n\tvar x = 1\n"}
+	opts := &FingerprintOptions{ExcludeLine: true, FileReader: before}
+	fp := DiagnosticFingerprint(at(3), opts)
+	opts.FileReader = after
+	if got := DiagnosticFingerprint(at(4), opts); got != fp {
+		t.Errorf("fingerprint changed by line shift: %q != %q", got, fp)
+	}
+	if got := DiagnosticFingerprint(at(3), opts); got == fp {
+		t.Errorf("fingerprint of a different snippet is the same %q", got)
+	}
+	if DiagnosticFingerprint(at(3), nil) == DiagnosticFingerprint(at(4), nil) {
+		t.Error("fingerprints without options should depend on the line")
+	}
+	noReader := &FingerprintOptions{ExcludeLine: true}
+	if DiagnosticFingerprint(at(3), noReader) != DiagnosticFingerprint(at(4), noReader) {
+		t.Error("fingerprints with ExcludeLine should not depend on the line")
+	}
+}
+
+func TestWriteFingerprintIndex(t *testing.T) {
+	results := []*rdf.Diagnostic{
+		{
+			Message:  "msg",
+			Severity: rdf.Severity_WARNING,
+			Code:     &rdf.Code{Value: "unused"},
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 1}}},
+		},
+		{
+			Message:     "reported",
+			Fingerprint: "tool-fingerprint",
+			Location:    &rdf.Location{Path: "b.go"},
+		},
+	}
+	var b strings.Builder
+	if err := WriteFingerprintIndex(&b, results); err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]FingerprintIndexEntry
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]FingerprintIndexEntry{
+		DiagnosticFingerprint(results[0], nil): {Path: "a.go", Line: 1, Code: "unused", Severity: "WARNING", Message: "msg"},
+		"tool-fingerprint":                     {Path: "b.go", Message: "reported"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("index (-want +got):\n%s", diff)
+	}
+
+	// Fingerprints in the index are seen by the next run.
+	seen := make(map[string]bool, len(got))
+	for fp := range got {
+		seen[fp] = true
+	}
+	p, err := New(&Option{FormatName: "rdjsonl", SeenFingerprints: seen})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(`{"message":"msg","severity":"WARNING","code":{"value":"unused"},"location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"new","location":{"path":"a.go","range":{"start":{"line":2}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 1 || ds[0].GetMessage() != "new" {
+		t.Errorf("got %v, want only the new diagnostic", ds)
+	}
+}
+
+func TestWriteFingerprintIndex_decorated(t *testing.T) {
+	const sample = `{"message":"msg","severity":"WARNING","location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"other","severity":"ERROR","location":{"path":"a.go","range":{"start":{"line":2}}}}`
+	parse := func(seen map[string]bool) []*rdf.Diagnostic {
+		t.Helper()
+		p, err := New(&Option{FormatName: "rdjsonl", SeenFingerprints: seen, SeverityEmoji: true, MessageSuffix: "(from CI)"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ds
+	}
+	ds := parse(make(map[string]bool))
+	if len(ds) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(ds))
+	}
+	var b strings.Builder
+	if err := WriteFingerprintIndex(&b, ds); err != nil {
+		t.Fatal(err)
+	}
+	var index map[string]FingerprintIndexEntry
+	if err := json.Unmarshal([]byte(b.String()), &index); err != nil {
+		t.Fatal(err)
+	}
+
+	// The next run with the loaded index reports nothing.
+	seen := make(map[string]bool, len(index))
+	for fp := range index {
+		seen[fp] = true
+	}
+	if ds := parse(seen); len(ds) != 0 {
+		t.Errorf("got %d diagnostics with the loaded index, want 0: %v", len(ds), ds)
+	}
+}
@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &FramedRDJSONParser{}
+
+// maxFrameSize is the max size of a frame FramedRDJSONParser accepts. It
+// guards against allocating a huge buffer for a corrupted length prefix.
+const maxFrameSize = 64 << 20 // 64 MiB
+
+// FramedRDJSONParser is parser for a stream of length-prefixed rdjson
+// (DiagnosticResult) documents. Each frame consists of 4-byte big-endian
+// length followed by rdjson document of the length. It's useful to read
+// results from long-lived connection such as a linter daemon.
+type FramedRDJSONParser struct{}
+
+// NewFramedRDJSONParser returns a new FramedRDJSONParser.
+func NewFramedRDJSONParser() *FramedRDJSONParser {
+	return &FramedRDJSONParser{}
+}
+
+// Parse reads frames until EOF and returns diagnostics of all frames.
+func (p *FramedRDJSONParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var results []*rdf.Diagnostic
+	for i := 0; ; i++ {
+		var size uint32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			if err == io.EOF {
+				return results, nil
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, fmt.Errorf("frame %d: truncated length prefix", i)
+			}
+			return nil, err
+		}
+		if size > maxFrameSize {
+			return nil, fmt.Errorf("frame %d: frame size %d bytes exceeds max %d bytes", i, size, maxFrameSize)
+		}
+		b := make([]byte, size)
+		if n, err := io.ReadFull(r, b); err != nil {
+			if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, fmt.Errorf("frame %d: truncated frame: got %d bytes, want %d bytes", i, n, size)
+			}
+			return nil, err
+		}
+		ds, err := parseRDJSON(b, "rdjson-framed")
+		if err != nil {
+			return nil, fmt.Errorf("frame %d: %w", i, err)
+		}
+		results = append(results, ds...)
+	}
+}
@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func frame(doc string) []byte {
+	b := make([]byte, 4, 4+len(doc))
+	binary.BigEndian.PutUint32(b, uint32(len(doc)))
+	return append(b, doc...)
+}
+
+func TestFramedRDJSONParser(t *testing.T) {
+	var buf bytes.Buffer
+	buf.Write(frame(`{"source":{"name":"linter1"},"diagnostics":[{"message":"msg1","location":{"path":"a.go"}},{"message":"msg2","location":{"path":"b.go"}}]}`))
+	buf.Write(frame(`{"source":{"name":"linter2"},"severity":"ERROR","diagnostics":[{"message":"msg3","location":{"path":"c.go"}}]}`))
+
+	diagnostics, err := NewFramedRDJSONParser().Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		msg, source string
+	}{
+		{"msg1", "linter1"},
+		{"msg2", "linter1"},
+		{"msg3", "linter2"},
+	}
+	if len(diagnostics) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d", len(diagnostics), len(want))
+	}
+	for i, d := range diagnostics {
+		if got := d.GetMessage(); got != want[i].msg {
+			t.Errorf("%d: message: got %q, want %q", i, got, want[i].msg)
+		}
+		if got := d.GetSource().GetName(); got != want[i].source {
+			t.Errorf("%d: source: got %q, want %q", i, got, want[i].source)
+		}
+	}
+	if got := diagnostics[2].GetSeverity().String(); got != "ERROR" {
+		t.Errorf("severity: got %v, want ERROR", got)
+	}
+}
+
+func TestFramedRDJSONParser_truncated(t *testing.T) {
+	full := frame(`{"diagnostics":[{"message":"msg1"}]}`)
+	tests := []struct {
+		in   []byte
+		want string
+	}{
+		{in: full[:2], want: "frame 0: truncated length prefix"},
+		{in: full[:10], want: "frame 0: truncated frame"},
+		{in: append(append([]byte{}, full...), full[:len(full)-1]...), want: "frame 1: truncated frame"},
+	}
+	for _, tt := range tests {
+		_, err := NewFramedRDJSONParser().Parse(bytes.NewReader(tt.in))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("got error %v, want %q", err, tt.want)
+		}
+	}
+}
+
+func TestFramedRDJSONParser_tooLarge(t *testing.T) {
+	in := []byte{0xff, 0xff, 0xff, 0xff, '{', '}'}
+	_, err := NewFramedRDJSONParser().Parse(bytes.NewReader(in))
+	if want := "frame 0: frame size 4294967295 bytes exceeds max"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,94 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GCCParser{}
+
+var (
+	// path:line[:col]: level: message
+	gccLineRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?: (fatal error|error|warning|note): (.*)$`)
+	// message [-Wflag]
+	gccFlagRe = regexp.MustCompile(`^(.*) \[(-W[\w=+-]+)\]$`)
+)
+
+// GCCParser is parser for GCC (and Clang) diagnostics.
+//
+//	path:line:col: error: message
+//	path:line:col: warning: message [-Wflag]
+//	path:line:col: note: message
+//
+// note: lines are attached to the preceding diagnostic as related locations.
+// https://gcc.gnu.org/onlinedocs/gcc/Diagnostic-Message-Formatting-Options.html
+type GCCParser struct{}
+
+// NewGCCParser returns a new GCCParser.
+func NewGCCParser() *GCCParser {
+	return &GCCParser{}
+}
+
+// Parse parses GCC diagnostics. Lines which don't look like diagnostics (e.g.
+// "In function" headers, source lines and carets) are ignored. Notes without
+// preceding diagnostics are reported as INFO diagnostics.
+func (p *GCCParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	var last *rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := gccLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		loc := &rdf.Location{
+			Path: m[1],
+			Range: &rdf.Range{
+				Start: &rdf.Position{
+					Line:   int32(lnum),
+					Column: int32(col),
+				},
+			},
+		}
+		if m[4] == "note" && last != nil {
+			last.RelatedLocations = append(last.RelatedLocations, &rdf.RelatedLocation{
+				Message:  m[5],
+				Location: loc,
+			})
+			last.OriginalOutput += "\n" + line
+			continue
+		}
+		d := &rdf.Diagnostic{
+			Location:       loc,
+			Message:        m[5],
+			Severity:       gccSeverity(m[4]),
+			Format:         "gcc",
+			OriginalOutput: line,
+		}
+		if fm := gccFlagRe.FindStringSubmatch(d.Message); fm != nil {
+			d.Message = fm[1]
+			d.Code = &rdf.Code{Value: fm[2]}
+		}
+		ds = append(ds, d)
+		last = d
+	}
+	return ds, s.Err()
+}
+
+func gccSeverity(level string) rdf.Severity {
+	switch level {
+	case "fatal error", "error":
+		return rdf.Severity_ERROR
+	case "warning":
+		return rdf.Severity_WARNING
+	default:
+		return rdf.Severity_INFO
+	}
+}
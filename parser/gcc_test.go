@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGCCParser() {
+	// gcc -c -Wall main.c
+	const sample = `main.c: In function 'main':
+main.c:8:5: error: too many arguments to function 'greet'
+    8 |     greet("world", 1);
+      |     ^~~~~
+In file included from main.c:1:
+greet.h:3:6: note: declared here
+    3 | void greet(const char *name);
+      |      ^~~~~
+main.c:2:10: note: previous declaration in this include
+main.c:6:9: warning: unused variable 'n' [-Wunused-variable]
+    6 |     int n;
+      |         ^`
+
+	p := NewGCCParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "too many arguments to function 'greet'",
+	//   "location": {
+	//     "path": "main.c",
+	//     "range": {
+	//       "start": {
+	//         "line": 8,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "main.c:8:5: error: too many arguments to function 'greet'\ngreet.h:3:6: note: declared here\nmain.c:2:10: note: previous declaration in this include",
+	//   "format": "gcc",
+	//   "relatedLocations": [
+	//     {
+	//       "message": "declared here",
+	//       "location": {
+	//         "path": "greet.h",
+	//         "range": {
+	//           "start": {
+	//             "line": 3,
+	//             "column": 6
+	//           }
+	//         }
+	//       }
+	//     },
+	//     {
+	//       "message": "previous declaration in this include",
+	//       "location": {
+	//         "path": "main.c",
+	//         "range": {
+	//           "start": {
+	//             "line": 2,
+	//             "column": 10
+	//           }
+	//         }
+	//       }
+	//     }
+	//   ]
+	// }
+	// {
+	//   "message": "unused variable 'n'",
+	//   "location": {
+	//     "path": "main.c",
+	//     "range": {
+	//       "start": {
+	//         "line": 6,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "-Wunused-variable"
+	//   },
+	//   "originalOutput": "main.c:6:9: warning: unused variable 'n' [-Wunused-variable]",
+	//   "format": "gcc"
+	// }
+}
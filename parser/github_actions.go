@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GitHubActionsParser{}
+
+// GitHubActionsParser is parser for GitHub Actions workflow commands which
+// create annotations (e.g. stylelint --formatter github).
+//
+//	::error file=path,line=1,col=2,endLine=1,endColumn=5,title=title::message (rule)
+//
+// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions
+type GitHubActionsParser struct{}
+
+// NewGitHubActionsParser returns a new GitHubActionsParser.
+func NewGitHubActionsParser() *GitHubActionsParser {
+	return &GitHubActionsParser{}
+}
+
+var (
+	// ::command properties::message
+	githubActionsCommandRe = regexp.MustCompile(`^::(error|warning|notice)(?: ([^:]*))?::(.*)$`)
+	// message (rule)
+	githubActionsRuleRe = regexp.MustCompile(`^(.*) \(([\w@/-]+)\)$`)
+
+	githubActionsPropertyUnescaper = strings.NewReplacer("%0D", "\r", "%0A", "\n", "%3A", ":", "%2C", ",", "%25", "%")
+	githubActionsDataUnescaper     = strings.NewReplacer("%0D", "\r", "%0A", "\n", "%25", "%")
+)
+
+// Parse parses GitHub Actions annotation commands. Other lines (e.g. ::debug
+// commands and plain logs) are ignored. Trailing rule names in parentheses
+// are reported as codes.
+func (p *GitHubActionsParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := githubActionsCommandRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		props := make(map[string]string)
+		for _, kv := range strings.Split(m[2], ",") {
+			if i := strings.Index(kv, "="); i > 0 {
+				props[strings.TrimSpace(kv[:i])] = githubActionsPropertyUnescaper.Replace(kv[i+1:])
+			}
+		}
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: props["file"]},
+			Message:        githubActionsDataUnescaper.Replace(m[3]),
+			Severity:       severity(strings.Replace(m[1], "notice", "note", 1)),
+			Format:         "github-actions",
+			OriginalOutput: line,
+		}
+		if lnum := githubActionsInt(props, "line"); lnum > 0 {
+			d.Location.Range = &rdf.Range{
+				Start: &rdf.Position{Line: lnum, Column: githubActionsInt(props, "col")},
+			}
+			if end := githubActionsInt(props, "endLine"); end > 0 {
+				d.Location.Range.End = &rdf.Position{Line: end, Column: githubActionsInt(props, "endColumn")}
+			}
+		}
+		if rm := githubActionsRuleRe.FindStringSubmatch(d.Message); rm != nil {
+			d.Message = rm[1]
+			d.Code = &rdf.Code{Value: rm[2]}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
+
+func githubActionsInt(props map[string]string, key string) int32 {
+	n, _ := strconv.Atoi(props[key])
+	return int32(n)
+}
@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGitHubActionsParser() {
+	const sample = `::group::Run linters
+::error file=src/app.go,line=10,col=2,endLine=12,endColumn=5,title=lint::multi%0Aline message
+::notice file=README.md,line=3::consider rewording
+::debug::not an annotation
+::endgroup::
+`
+
+	p := NewGitHubActionsParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "multi\nline message",
+	//   "location": {
+	//     "path": "src/app.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 2
+	//       },
+	//       "end": {
+	//         "line": 12,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "::error file=src/app.go,line=10,col=2,endLine=12,endColumn=5,title=lint::multi%0Aline message",
+	//   "format": "github-actions"
+	// }
+	// {
+	//   "message": "consider rewording",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "::notice file=README.md,line=3::consider rewording",
+	//   "format": "github-actions"
+	// }
+}
+
+func ExampleGitHubActionsParser_stylelint() {
+	// stylelint --formatter github "**/*.css"
+	const sample = `::error file=src/a.css,line=1,col=6,endLine=1,endColumn=12,title=Stylelint problem::Unexpected invalid hex color "#ababa" (color-no-invalid-hex)
+::warning file=src/b.scss,line=4,col=3,title=Stylelint problem::Unexpected unknown at-rule "@include" (scss/at-rule-no-unknown)
+`
+
+	p := NewGitHubActionsParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Unexpected invalid hex color \"#ababa\"",
+	//   "location": {
+	//     "path": "src/a.css",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 6
+	//       },
+	//       "end": {
+	//         "line": 1,
+	//         "column": 12
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "color-no-invalid-hex"
+	//   },
+	//   "originalOutput": "::error file=src/a.css,line=1,col=6,endLine=1,endColumn=12,title=Stylelint problem::Unexpected invalid hex color \"#ababa\" (color-no-invalid-hex)",
+	//   "format": "github-actions"
+	// }
+	// {
+	//   "message": "Unexpected unknown at-rule \"@include\"",
+	//   "location": {
+	//     "path": "src/b.scss",
+	//     "range": {
+	//       "start": {
+	//         "line": 4,
+	//         "column": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "scss/at-rule-no-unknown"
+	//   },
+	//   "originalOutput": "::warning file=src/b.scss,line=4,col=3,title=Stylelint problem::Unexpected unknown at-rule \"@include\" (scss/at-rule-no-unknown)",
+	//   "format": "github-actions"
+	// }
+}
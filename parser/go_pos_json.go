@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GoPosJSONParser{}
+
+// GoPosJSONParser is parser for a stream of JSON objects with Pos and Message
+// fields, which is emitted by JSON wrappers of Go tools (e.g. nilaway and
+// errcheck).
+//
+//	{"Pos":"path/to/file.go:10:2","Message":"message"}
+//	{"Pos":"path/to/file.go:12","Message":"message"}
+type GoPosJSONParser struct{}
+
+// NewGoPosJSONParser returns a new GoPosJSONParser.
+func NewGoPosJSONParser() *GoPosJSONParser {
+	return &GoPosJSONParser{}
+}
+
+// file:line[:col]
+var goPosRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?$`)
+
+// Parse parses a stream of Go position JSON objects. Diagnostics are
+// reported as warnings as the objects don't have severities.
+func (p *GoPosJSONParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	dec := json.NewDecoder(r)
+	for {
+		var obj GoPosJSON
+		if err := dec.Decode(&obj); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode Go position JSON: %w", err)
+		}
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: obj.Pos},
+			Message:        obj.Message,
+			Severity:       rdf.Severity_WARNING,
+			Format:         "go-pos-json",
+			OriginalOutput: fmt.Sprintf("%s: %s", obj.Pos, obj.Message),
+		}
+		if m := goPosRe.FindStringSubmatch(obj.Pos); m != nil {
+			lnum, _ := strconv.Atoi(m[2])
+			col, _ := strconv.Atoi(m[3])
+			d.Location = &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(lnum), Column: int32(col)},
+				},
+			}
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// GoPosJSON represents a diagnostic of Go tools in JSON. Pos is in
+// "file:line:col" or "file:line" form.
+type GoPosJSON struct {
+	Pos     string `json:"Pos"`
+	Message string `json:"Message"`
+}
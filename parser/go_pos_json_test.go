@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGoPosJSONParser() {
+	const sample = `{"Pos":"pkg/server/handler.go:42:9","Message":"Potential nil panic detected. Observed nil flow from source to dereference point"}
+{"Pos":"main.go:15","Message":"error return value not checked (os.Remove)"}
+`
+
+	p := NewGoPosJSONParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Potential nil panic detected. Observed nil flow from source to dereference point",
+	//   "location": {
+	//     "path": "pkg/server/handler.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 42,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "pkg/server/handler.go:42:9: Potential nil panic detected. Observed nil flow from source to dereference point",
+	//   "format": "go-pos-json"
+	// }
+	// {
+	//   "message": "error return value not checked (os.Remove)",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "main.go:15: error return value not checked (os.Remove)",
+	//   "format": "go-pos-json"
+	// }
+}
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GocycloParser{}
+
+// GocycloParser is parser for output of Go complexity checkers, gocyclo
+// and gocognit.
+//
+//	complexity package function path:line:col
+//
+// https://github.com/fzipp/gocyclo
+// https://github.com/uudashr/gocognit
+type GocycloParser struct{}
+
+// NewGocycloParser returns a new GocycloParser.
+func NewGocycloParser() *GocycloParser {
+	return &GocycloParser{}
+}
+
+// complexity package function path:line:col
+var gocycloRe = regexp.MustCompile(`^(\d+) (\S+) (\S+) (.+):(\d+):(\d+)$`)
+
+// Parse parses gocyclo and gocognit output. Functions are reported as codes
+// so that they can be matched stably. Other lines (e.g. averages) are
+// ignored.
+func (p *GocycloParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := gocycloRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[5])
+		col, _ := strconv.Atoi(m[6])
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[4],
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(lnum), Column: int32(col)},
+				},
+			},
+			Message:        fmt.Sprintf("function %s.%s has complexity %s", m[2], m[3], m[1]),
+			Severity:       rdf.Severity_WARNING,
+			Code:           &rdf.Code{Value: m[3]},
+			Format:         "gocyclo",
+			OriginalOutput: s.Text(),
+		})
+	}
+	return ds, s.Err()
+}
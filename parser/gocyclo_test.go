@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGocycloParser() {
+	// gocyclo -over 15 .
+	const sample = `24 parser (*SarifParser).Parse parser/sarif.go:31:1
+16 main run cmd/reviewdog/main.go:212:1
+`
+
+	p := NewGocycloParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "function parser.(*SarifParser).Parse has complexity 24",
+	//   "location": {
+	//     "path": "parser/sarif.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 31,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "(*SarifParser).Parse"
+	//   },
+	//   "originalOutput": "24 parser (*SarifParser).Parse parser/sarif.go:31:1",
+	//   "format": "gocyclo"
+	// }
+	// {
+	//   "message": "function main.run has complexity 16",
+	//   "location": {
+	//     "path": "cmd/reviewdog/main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 212,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "run"
+	//   },
+	//   "originalOutput": "16 main run cmd/reviewdog/main.go:212:1",
+	//   "format": "gocyclo"
+	// }
+}
+
+func ExampleGocycloParser_gocognit() {
+	// gocognit -over 10 -avg .
+	const sample = `31 parser newProcessor parser/process.go:47:1
+12 service (*Handler).ServeHTTP internal/service/handler.go:20:1
+Average: 4.35
+`
+
+	p := NewGocycloParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		fmt.Printf("%s:%d: %s [%s]\n", d.GetLocation().GetPath(), d.GetLocation().GetRange().GetStart().GetLine(), d.GetMessage(), d.GetCode().GetValue())
+	}
+	// Output:
+	// parser/process.go:47: function parser.newProcessor has complexity 31 [newProcessor]
+	// internal/service/handler.go:20: function service.(*Handler).ServeHTTP has complexity 12 [(*Handler).ServeHTTP]
+}
@@ -0,0 +1,40 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GofmtListParser{}
+
+// GofmtListParser is parser for `gofmt -l` output, which lists unformatted
+// files one per line. Each file is reported as a file-level diagnostic.
+type GofmtListParser struct{}
+
+// NewGofmtListParser returns a new GofmtListParser.
+func NewGofmtListParser() *GofmtListParser {
+	return &GofmtListParser{}
+}
+
+// Parse parses `gofmt -l` output. Blank lines are skipped.
+func (p *GofmtListParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		path := strings.TrimSpace(s.Text())
+		if path == "" {
+			continue
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: path},
+			Message:        "file is not gofmt-formatted",
+			Severity:       rdf.Severity_WARNING,
+			Format:         "gofmt-list",
+			OriginalOutput: s.Text(),
+		})
+	}
+	return ds, s.Err()
+}
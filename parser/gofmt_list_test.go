@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGofmtListParser() {
+	const sample = `main.go
+
+internal/foo/foo.go
+cmd/bar/main.go
+`
+
+	p := NewGofmtListParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "file is not gofmt-formatted",
+	//   "location": {
+	//     "path": "main.go"
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "main.go",
+	//   "format": "gofmt-list"
+	// }
+	// {
+	//   "message": "file is not gofmt-formatted",
+	//   "location": {
+	//     "path": "internal/foo/foo.go"
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "internal/foo/foo.go",
+	//   "format": "gofmt-list"
+	// }
+	// {
+	//   "message": "file is not gofmt-formatted",
+	//   "location": {
+	//     "path": "cmd/bar/main.go"
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "cmd/bar/main.go",
+	//   "format": "gofmt-list"
+	// }
+}
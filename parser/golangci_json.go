@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GolangCIJSONParser{}
+
+// GolangCIJSONParser is parser for golangci-lint JSON output
+// (golangci-lint run --out-format=json).
+// https://golangci-lint.run/usage/configuration/#output-configuration
+type GolangCIJSONParser struct {
+	// fr reads files to convert offsets of positions without line.
+	fr FileReader
+	// emitLinters reports enabled linters in Report as a run-level INFO
+	// diagnostic.
+	emitLinters bool
+}
+
+// NewGolangCIJSONParser returns a new GolangCIJSONParser.
+func NewGolangCIJSONParser() *GolangCIJSONParser {
+	return &GolangCIJSONParser{}
+}
+
+// Parse parses golangci-lint JSON output. Run-level errors and warnings in
+// Report (e.g. config or typecheck failures) are reported as diagnostics
+// without location so that they aren't silently lost. Positions without line
+// are converted from offsets by reading files if FileReader is given.
+func (p *GolangCIJSONParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var out GolangCIJSONOutput
+	if err := json.NewDecoder(r).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode golangci-lint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	contents := make(map[string][]byte)
+	for _, issue := range out.Issues {
+		if issue.Pos.Line == 0 && issue.Pos.Offset > 0 && p.fr != nil {
+			p.resolveOffset(contents, &issue.Pos)
+		}
+		pos := issue.Pos
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: pos.Filename,
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(pos.Line), Column: int32(pos.Column)},
+				},
+			},
+			Message:  issue.Text,
+			Severity: ParseSeverity(issue.Severity),
+			Lines:    issue.SourceLines,
+			Format:   "golangci-lint-json",
+			OriginalOutput: fmt.Sprintf("%s:%d:%d: %s (%s)",
+				pos.Filename, pos.Line, pos.Column, issue.Text, issue.FromLinter),
+		}
+		if issue.FromLinter != "" {
+			d.Code = &rdf.Code{Value: issue.FromLinter}
+		}
+		if s := issue.suggestion(); s != nil {
+			d.Suggestions = []*rdf.Suggestion{s}
+		}
+		ds = append(ds, d)
+	}
+	if report := out.Report; report != nil {
+		if report.Error != "" {
+			ds = append(ds, golangciReportDiagnostic(report.Error, rdf.Severity_ERROR))
+		}
+		for _, w := range report.Warnings {
+			msg := w.Text
+			if w.Tag != "" {
+				msg = w.Tag + ": " + msg
+			}
+			ds = append(ds, golangciReportDiagnostic(msg, rdf.Severity_WARNING))
+		}
+		if p.emitLinters {
+			var enabled []string
+			for _, l := range report.Linters {
+				if l.Enabled {
+					enabled = append(enabled, l.Name)
+				}
+			}
+			if len(enabled) > 0 {
+				msg := "enabled linters: " + strings.Join(enabled, ", ")
+				ds = append(ds, golangciReportDiagnostic(msg, rdf.Severity_INFO))
+			}
+		}
+	}
+	return ds, nil
+}
+
+// resolveOffset sets line and column of the position from its offset. The
+// contents of files are cached in contents.
+func (p *GolangCIJSONParser) resolveOffset(contents map[string][]byte, pos *GolangCIPosition) {
+	content, ok := contents[pos.Filename]
+	if !ok {
+		content, _ = p.fr.ReadFile(pos.Filename)
+		contents[pos.Filename] = content
+	}
+	if lc := offsetPosition(content, pos.Offset); lc != nil {
+		pos.Line, pos.Column = int(lc.Line), int(lc.Column)
+	}
+}
+
+// suggestion returns the suggestion of the replacement of the issue, or nil
+// if there is none. Line replacements (e.g. of gofumpt and gci) cover the
+// lines in LineRange, or the issue line if LineRange is absent.
+func (issue *GolangCIIssue) suggestion() *rdf.Suggestion {
+	rep := issue.Replacement
+	if rep == nil {
+		return nil
+	}
+	if in := rep.Inline; in != nil {
+		line := int32(issue.Pos.Line)
+		return &rdf.Suggestion{
+			Range: &rdf.Range{
+				Start: &rdf.Position{Line: line, Column: int32(in.StartCol + 1)},
+				End:   &rdf.Position{Line: line, Column: int32(in.StartCol + in.Length + 1)},
+			},
+			Text: in.NewString,
+		}
+	}
+	if len(rep.NewLines) == 0 && !rep.NeedOnlyDelete {
+		return nil
+	}
+	from, to := issue.Pos.Line, issue.Pos.Line
+	if lr := issue.LineRange; lr != nil && lr.From > 0 {
+		from, to = lr.From, lr.To
+		if to < from {
+			to = from
+		}
+	}
+	return &rdf.Suggestion{
+		Range: &rdf.Range{
+			Start: &rdf.Position{Line: int32(from)},
+			End:   &rdf.Position{Line: int32(to)},
+		},
+		Text: strings.Join(rep.NewLines, "\n"),
+	}
+}
+
+// golangciReportDiagnostic returns a run-level diagnostic of Report.
+func golangciReportDiagnostic(msg string, sev rdf.Severity) *rdf.Diagnostic {
+	return &rdf.Diagnostic{
+		Message:        msg,
+		Severity:       sev,
+		Source:         &rdf.Source{Name: "golangci-lint"},
+		Format:         "golangci-lint-json",
+		OriginalOutput: msg,
+	}
+}
+
+// GolangCIJSONOutput represents golangci-lint JSON output.
+// {"Issues":[{"FromLinter":"errcheck","Text":"msg","Severity":"","SourceLines":["\tos.Open(\"abc\")"],"Replacement":null,"Pos":{"Filename":"main.go","Offset":120,"Line":15,"Column":9}}],"Report":{"Warnings":[{"Tag":"runner","Text":"msg"}],"Linters":[{"Name":"errcheck","Enabled":true}],"Error":"msg"}}
+type GolangCIJSONOutput struct {
+	Issues []*GolangCIIssue `json:"Issues"`
+	Report *GolangCIReport  `json:"Report"`
+}
+
+// GolangCIIssue represents an issue reported by a linter.
+type GolangCIIssue struct {
+	FromLinter  string               `json:"FromLinter"`
+	Text        string               `json:"Text"`
+	Severity    string               `json:"Severity"`
+	SourceLines []string             `json:"SourceLines"`
+	Replacement *GolangCIReplacement `json:"Replacement"`
+	LineRange   *GolangCILineRange   `json:"LineRange"`
+	Pos         GolangCIPosition     `json:"Pos"`
+}
+
+// GolangCIReplacement represents a fix of an issue, which replaces lines of
+// LineRange with NewLines (or deletes them if NeedOnlyDelete), or a part of
+// the issue line if Inline is set.
+// {"NeedOnlyDelete":false,"NewLines":["import (",")"],"Inline":null}
+type GolangCIReplacement struct {
+	NeedOnlyDelete bool                       `json:"NeedOnlyDelete"`
+	NewLines       []string                   `json:"NewLines"`
+	Inline         *GolangCIInlineReplacement `json:"Inline"`
+}
+
+// GolangCIInlineReplacement replaces Length bytes from 0-based StartCol of
+// the issue line with NewString.
+type GolangCIInlineReplacement struct {
+	StartCol  int    `json:"StartCol"`
+	Length    int    `json:"Length"`
+	NewString string `json:"NewString"`
+}
+
+// GolangCILineRange represents lines of an issue. Both ends are inclusive.
+type GolangCILineRange struct {
+	From int `json:"From"`
+	To   int `json:"To"`
+}
+
+// GolangCIPosition represents a position of an issue. Line and column are
+// 1-based and offset is 0-based bytes.
+type GolangCIPosition struct {
+	Filename string `json:"Filename"`
+	Offset   int    `json:"Offset"`
+	Line     int    `json:"Line"`
+	Column   int    `json:"Column"`
+}
+
+// GolangCIReport represents the run-level report.
+type GolangCIReport struct {
+	Warnings []*GolangCIReportWarning `json:"Warnings"`
+	Linters  []*GolangCILinter        `json:"Linters"`
+	Error    string                   `json:"Error"`
+}
+
+// GolangCILinter represents a linter known to golangci-lint and whether it
+// ran.
+type GolangCILinter struct {
+	Name             string `json:"Name"`
+	Enabled          bool   `json:"Enabled"`
+	EnabledByDefault bool   `json:"EnabledByDefault"`
+}
+
+// GolangCIReportWarning represents a run-level warning.
+type GolangCIReportWarning struct {
+	Tag  string `json:"Tag"`
+	Text string `json:"Text"`
+}
@@ -0,0 +1,291 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func ExampleGolangCIJSONParser() {
+	// golangci-lint run --out-format=json
+	const sample = `{
+  "Issues": [
+    {
+      "FromLinter": "errcheck",
+      "Text": "Error return value of ` + "`os.Open`" + ` is not checked",
+      "Severity": "",
+      "SourceLines": ["\tos.Open(\"abc\")"],
+      "Replacement": null,
+      "Pos": {"Filename": "main.go", "Offset": 120, "Line": 15, "Column": 9},
+      "ExpectNoLint": false,
+      "ExpectedNoLintLinter": ""
+    },
+    {
+      "FromLinter": "govet",
+      "Text": "printf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+      "Severity": "warning",
+      "SourceLines": ["\t_ = fmt.Sprintf(\"%d\")"],
+      "Replacement": null,
+      "Pos": {"Filename": "main.go", "Offset": 98, "Line": 13, "Column": 2}
+    }
+  ],
+  "Report": {
+    "Warnings": [
+      {"Tag": "runner", "Text": "The linter 'golint' is deprecated (since v1.41.0) due to: The repository of the linter has been archived by the owner."}
+    ],
+    "Linters": [
+      {"Name": "errcheck", "Enabled": true, "EnabledByDefault": true},
+      {"Name": "govet", "Enabled": true, "EnabledByDefault": true}
+    ]
+  }
+}`
+
+	p := NewGolangCIJSONParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15:9: Error return value of `os.Open` is not checked (errcheck)",
+	//   "format": "golangci-lint-json",
+	//   "lines": [
+	//     "\tos.Open(\"abc\")"
+	//   ]
+	// }
+	// {
+	//   "message": "printf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 13,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "govet"
+	//   },
+	//   "originalOutput": "main.go:13:2: printf: fmt.Sprintf format %d reads arg #1, but call has 0 args (govet)",
+	//   "format": "golangci-lint-json",
+	//   "lines": [
+	//     "\t_ = fmt.Sprintf(\"%d\")"
+	//   ]
+	// }
+	// {
+	//   "message": "runner: The linter 'golint' is deprecated (since v1.41.0) due to: The repository of the linter has been archived by the owner.",
+	//   "severity": "WARNING",
+	//   "source": {
+	//     "name": "golangci-lint"
+	//   },
+	//   "originalOutput": "runner: The linter 'golint' is deprecated (since v1.41.0) due to: The repository of the linter has been archived by the owner.",
+	//   "format": "golangci-lint-json"
+	// }
+}
+
+func ExampleGolangCIJSONParser_reportError() {
+	// Run-level error without issues.
+	const sample = `{
+  "Issues": [],
+  "Report": {
+    "Linters": [{"Name": "typecheck", "Enabled": true}],
+    "Error": "can't load config: unknown linters: 'foolint', run 'golangci-lint help linters' to see the list of supported linters"
+  }
+}`
+
+	p := NewGolangCIJSONParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "can't load config: unknown linters: 'foolint', run 'golangci-lint help linters' to see the list of supported linters",
+	//   "severity": "ERROR",
+	//   "source": {
+	//     "name": "golangci-lint"
+	//   },
+	//   "originalOutput": "can't load config: unknown linters: 'foolint', run 'golangci-lint help linters' to see the list of supported linters",
+	//   "format": "golangci-lint-json"
+	// }
+}
+
+func TestGolangCIJSONParser_replacement(t *testing.T) {
+	// golangci-lint run --out-format=json --enable-only=gofumpt,misspell
+	const sample = `{
+  "Issues": [
+    {
+      "FromLinter": "gofumpt",
+      "Text": "File is not ` + "`gofumpt`" + `-ed",
+      "SourceLines": ["func f() {", "", "\treturn", "}"],
+      "Replacement": {"NeedOnlyDelete": false, "NewLines": ["func f() {", "\treturn", "}"], "Inline": null},
+      "LineRange": {"From": 3, "To": 6},
+      "Pos": {"Filename": "main.go", "Offset": 0, "Line": 3, "Column": 0}
+    },
+    {
+      "FromLinter": "misspell",
+      "Text": "` + "`langauge`" + ` is a misspelling of ` + "`language`" + `",
+      "Replacement": {"NeedOnlyDelete": false, "NewLines": null, "Inline": {"StartCol": 4, "Length": 8, "NewString": "language"}},
+      "Pos": {"Filename": "main.go", "Offset": 40, "Line": 8, "Column": 5}
+    },
+    {
+      "FromLinter": "dupword",
+      "Text": "Duplicate words (the) found",
+      "Replacement": {"NeedOnlyDelete": true, "NewLines": null, "Inline": null},
+      "Pos": {"Filename": "main.go", "Offset": 60, "Line": 10, "Column": 1}
+    }
+  ]
+}`
+	ds, err := NewGolangCIJSONParser().Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]*rdf.Suggestion{
+		{{
+			Range: &rdf.Range{Start: &rdf.Position{Line: 3}, End: &rdf.Position{Line: 6}},
+			Text:  "func f() {\n\treturn\n}",
+		}},
+		{{
+			Range: &rdf.Range{Start: &rdf.Position{Line: 8, Column: 5}, End: &rdf.Position{Line: 8, Column: 13}},
+			Text:  "language",
+		}},
+		{{
+			Range: &rdf.Range{Start: &rdf.Position{Line: 10}, End: &rdf.Position{Line: 10}},
+		}},
+	}
+	var got [][]*rdf.Suggestion
+	for _, d := range ds {
+		got = append(got, d.GetSuggestions())
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("suggestions (-want +got):\n%s", diff)
+	}
+}
+
+func TestGolangCIJSONParser_offset(t *testing.T) {
+	const sample = `{
+  "Issues": [
+    {
+      "FromLinter": "unused",
+      "Text": "func ` + "`foo`" + ` is unused",
+      "Pos": {"Filename": "main.go", "Offset": 29, "Line": 0, "Column": 0}
+    },
+    {
+      "FromLinter": "unused",
+      "Text": "out of file",
+      "Pos": {"Filename": "main.go", "Offset": 100, "Line": 0, "Column": 0}
+    },
+    {
+      "FromLinter": "govet",
+      "Text": "with line",
+      "Pos": {"Filename": "main.go", "Offset": 29, "Line": 1, "Column": 1}
+    }
+  ]
+}`
+	fr := fakeFileReader{"main.go": "package main\n\nfunc main() {\n\tfoo()\n}\n"}
+	tests := []struct {
+		opt  *Option
+		want []*rdf.Position
+	}{
+		{
+			opt:  &Option{FormatName: "golangci-lint-json"},
+			want: []*rdf.Position{{}, {}, {Line: 1, Column: 1}},
+		},
+		{
+			opt:  &Option{FormatName: "golangci-lint-json", FileReader: fr},
+			want: []*rdf.Position{{Line: 4, Column: 2}, {}, {Line: 1, Column: 1}},
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []*rdf.Position
+		for _, d := range ds {
+			got = append(got, d.GetLocation().GetRange().GetStart())
+		}
+		if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("FileReader=%v: positions (-want +got):\n%s", tt.opt.FileReader != nil, diff)
+		}
+	}
+}
+
+func TestGolangCIJSONParser_linters(t *testing.T) {
+	const sample = `{
+  "Issues": [],
+  "Report": {
+    "Linters": [
+      {"Name": "errcheck", "Enabled": true, "EnabledByDefault": true},
+      {"Name": "gofumpt"},
+      {"Name": "govet", "Enabled": true, "EnabledByDefault": true}
+    ]
+  }
+}`
+	tests := []struct {
+		emitMeta bool
+		want     []string
+	}{
+		{emitMeta: false},
+		{emitMeta: true, want: []string{"INFO: enabled linters: errcheck, govet"}},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "golangci-lint-json", EmitParseMeta: tt.emitMeta})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			if d.GetLocation() != nil {
+				t.Errorf("got location %v, want none", d.GetLocation())
+			}
+			got = append(got, d.GetSeverity().String()+": "+d.GetMessage())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("EmitParseMeta=%v: diagnostics (-want +got):\n%s", tt.emitMeta, diff)
+		}
+	}
+}
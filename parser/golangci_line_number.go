@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GolangCILineNumberParser{}
+
+// GolangCILineNumberParser is parser for golangci-lint line-number output
+// (golangci-lint run --out-format=line-number).
+//
+//	path:line:col: message (linter)
+//	path:line:col: message
+//
+// Unlike GolangCIPlainParser, messages are kept as is except for the linter
+// suffix.
+// https://golangci-lint.run/usage/configuration/#output-configuration
+type GolangCILineNumberParser struct{}
+
+// NewGolangCILineNumberParser returns a new GolangCILineNumberParser.
+func NewGolangCILineNumberParser() *GolangCILineNumberParser {
+	return &GolangCILineNumberParser{}
+}
+
+// Parse parses golangci-lint line-number output. Lines which don't look like
+// diagnostics are ignored.
+func (p *GolangCILineNumberParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := golangciPosRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[4],
+			Format:         "golangci-lint-line-number",
+			OriginalOutput: line,
+		}
+		if lm := golangciLinterRe.FindStringSubmatch(d.Message); lm != nil {
+			d.Message = lm[1]
+			d.Code = &rdf.Code{Value: lm[2]}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
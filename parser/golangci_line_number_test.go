@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGolangCILineNumberParser() {
+	// golangci-lint run --out-format=line-number
+	const sample = `main.go:15:9: Error return value of ` + "`os.Open`" + ` is not checked (errcheck)
+main.go:13:2: printf: Sprintf format %d reads arg #1, but call has 0 args (govet)
+pkg/a.go:3: File is not ` + "`gofmt`" + `-ed with ` + "`-s`" + `
+`
+
+	p := NewGolangCILineNumberParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15:9: Error return value of `os.Open` is not checked (errcheck)",
+	//   "format": "golangci-lint-line-number"
+	// }
+	// {
+	//   "message": "printf: Sprintf format %d reads arg #1, but call has 0 args",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 13,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "govet"
+	//   },
+	//   "originalOutput": "main.go:13:2: printf: Sprintf format %d reads arg #1, but call has 0 args (govet)",
+	//   "format": "golangci-lint-line-number"
+	// }
+	// {
+	//   "message": "File is not `gofmt`-ed with `-s`",
+	//   "location": {
+	//     "path": "pkg/a.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "originalOutput": "pkg/a.go:3: File is not `gofmt`-ed with `-s`",
+	//   "format": "golangci-lint-line-number"
+	// }
+}
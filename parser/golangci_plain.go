@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GolangCIPlainParser{}
+
+// GolangCIPlainParser is parser for golangci-lint line-number output with an
+// optional severity segment, which is printed when severity rules are
+// configured.
+//
+//	path:line:col: severity: message (linter)
+//	path:line:col: message (linter)
+//
+// Without the severity segment, severity is derived from the leading letter
+// of a check code prefixing the message (e.g. "W1001: "), as BracketTagParser
+// does.
+// https://golangci-lint.run/usage/configuration/#severity-configuration
+type GolangCIPlainParser struct{}
+
+// NewGolangCIPlainParser returns a new GolangCIPlainParser.
+func NewGolangCIPlainParser() *GolangCIPlainParser {
+	return &GolangCIPlainParser{}
+}
+
+var (
+	// path:line[:col]: rest
+	golangciPosRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?: (.*)$`)
+	// severity: message
+	golangciSeverityRe = regexp.MustCompile(`^(\w+): (.*)$`)
+	// CODE: message
+	golangciCheckCodeRe = regexp.MustCompile(`^([A-Z]+\d+): `)
+	// message (linter)
+	golangciLinterRe = regexp.MustCompile(`^(.*) \(([\w-]+)\)$`)
+)
+
+// Parse parses golangci-lint output. Lines which don't look like diagnostics
+// (e.g. source lines and carets) are ignored.
+func (p *GolangCIPlainParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if d := parseGolangCILine(s.Text()); d != nil {
+			ds = append(ds, d)
+		}
+	}
+	return ds, s.Err()
+}
+
+// parseGolangCILine parses a line of golangci-lint text output. It returns
+// nil if the line isn't a diagnostic.
+func parseGolangCILine(line string) *rdf.Diagnostic {
+	m := golangciPosRe.FindStringSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	lnum, _ := strconv.Atoi(m[2])
+	col, _ := strconv.Atoi(m[3])
+	d := &rdf.Diagnostic{
+		Location: &rdf.Location{
+			Path: m[1],
+			Range: &rdf.Range{
+				Start: &rdf.Position{
+					Line:   int32(lnum),
+					Column: int32(col),
+				},
+			},
+		},
+		Message:        m[4],
+		Format:         "golangci-lint-plain",
+		OriginalOutput: line,
+	}
+	// Only known severities are treated as the severity segment, since
+	// messages may have other prefixes (e.g. "printf: " of govet).
+	if sm := golangciSeverityRe.FindStringSubmatch(d.Message); sm != nil {
+		if sev := severity(sm[1]); sev != rdf.Severity_UNKNOWN_SEVERITY {
+			d.Severity = sev
+			d.Message = sm[2]
+		}
+	}
+	// Fall back to the check code only if the severity is absent.
+	if d.Severity == rdf.Severity_UNKNOWN_SEVERITY {
+		if cm := golangciCheckCodeRe.FindStringSubmatch(d.Message); cm != nil {
+			d.Severity = bracketTagSeverity(cm[1])
+		}
+	}
+	if lm := golangciLinterRe.FindStringSubmatch(d.Message); lm != nil {
+		d.Message = lm[1]
+		d.Code = &rdf.Code{Value: lm[2]}
+	}
+	return d
+}
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func ExampleGolangCIPlainParser() {
+	const sample = `main.go:15:9: error: Error return value of ` + "`os.Open`" + ` is not checked (errcheck)
+	os.Open("abc")
+	       ^
+main.go:13:2: warning: printf: Sprintf format %d reads arg #1, but call has 0 args (govet)
+main.go:12:2: ineffectual assignment to ` + "`x`" + ` (ineffassign)
+`
+
+	p := NewGolangCIPlainParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15:9: error: Error return value of `os.Open` is not checked (errcheck)",
+	//   "format": "golangci-lint-plain"
+	// }
+	// {
+	//   "message": "printf: Sprintf format %d reads arg #1, but call has 0 args",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 13,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "govet"
+	//   },
+	//   "originalOutput": "main.go:13:2: warning: printf: Sprintf format %d reads arg #1, but call has 0 args (govet)",
+	//   "format": "golangci-lint-plain"
+	// }
+	// {
+	//   "message": "ineffectual assignment to `x`",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "ineffassign"
+	//   },
+	//   "originalOutput": "main.go:12:2: ineffectual assignment to `x` (ineffassign)",
+	//   "format": "golangci-lint-plain"
+	// }
+}
+
+func TestGolangCIPlainParser_severity(t *testing.T) {
+	tests := []struct {
+		line string
+		want rdf.Severity
+	}{
+		// Explicit severity takes precedence over the check code.
+		{line: "main.go:1:1: info: E1101: Instance has no member (custom)", want: rdf.Severity_INFO},
+		{line: "main.go:1:1: error: W0612: Unused variable (custom)", want: rdf.Severity_ERROR},
+		// Fall back to the check code without severity.
+		{line: "main.go:1:1: W0612: Unused variable (custom)", want: rdf.Severity_WARNING},
+		{line: "main.go:1:1: E1101: Instance has no member (custom)", want: rdf.Severity_ERROR},
+		{line: "main.go:1:1: ineffectual assignment to x (ineffassign)", want: rdf.Severity_UNKNOWN_SEVERITY},
+	}
+	for _, tt := range tests {
+		ds, err := NewGolangCIPlainParser().Parse(strings.NewReader(tt.line))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != 1 {
+			t.Fatalf("%q: got %d diagnostics, want 1", tt.line, len(ds))
+		}
+		if got := ds[0].GetSeverity(); got != tt.want {
+			t.Errorf("%q: got severity %v, want %v", tt.line, got, tt.want)
+		}
+	}
+}
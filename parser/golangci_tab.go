@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GolangCITabParser{}
+
+// path:line[:col]
+var golangciTabPosRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?$`)
+
+// GolangCITabParser is parser for golangci-lint tab output
+// (golangci-lint run --out-format=tab), optionally with a severity column.
+//
+//	path:line:col	linter	message
+//	path:line:col	severity	linter	message
+//
+// https://golangci-lint.run/usage/configuration/#output-configuration
+type GolangCITabParser struct{}
+
+// NewGolangCITabParser returns a new GolangCITabParser.
+func NewGolangCITabParser() *GolangCITabParser {
+	return &GolangCITabParser{}
+}
+
+// Parse parses golangci-lint tab output. Rows with four fields are treated as
+// having the severity column. Lines which don't look like diagnostics are
+// ignored.
+func (p *GolangCITabParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		fields := strings.SplitN(line, "\t", 4)
+		if len(fields) < 3 {
+			continue
+		}
+		m := golangciTabPosRe.FindStringSubmatch(strings.TrimSpace(fields[0]))
+		if m == nil {
+			continue
+		}
+		var sev rdf.Severity
+		if len(fields) == 4 {
+			sev = ParseSeverity(strings.TrimSpace(fields[1]))
+			fields = fields[1:]
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        fields[2],
+			Severity:       sev,
+			Format:         "golangci-lint-tab",
+			OriginalOutput: line,
+		}
+		if linter := strings.TrimSpace(fields[1]); linter != "" {
+			d.Code = &rdf.Code{Value: linter}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
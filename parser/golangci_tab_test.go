@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func ExampleGolangCITabParser() {
+	// golangci-lint run --out-format=tab
+	const sample = "main.go:15:9\terrcheck\tError return value of `os.Open` is not checked\n" +
+		"main.go:13:2\terror\tgovet\tprintf: fmt.Sprintf format %d reads arg #1, but call has 0 args\n" +
+		"level=warning msg=\"[runner] Can't run linter goanalysis_metalinter\"\n"
+
+	p := NewGolangCITabParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "main.go:15:9\terrcheck\tError return value of `os.Open` is not checked",
+	//   "format": "golangci-lint-tab"
+	// }
+	// {
+	//   "message": "printf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 13,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "govet"
+	//   },
+	//   "originalOutput": "main.go:13:2\terror\tgovet\tprintf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+	//   "format": "golangci-lint-tab"
+	// }
+}
+
+func TestGolangCITabParser_coloredTab(t *testing.T) {
+	// golangci-lint run --out-format=colored-tab
+	const sample = "\x1b[1mmain.go:13:2\x1b[0m\t\x1b[31merror\x1b[0m\t\x1b[2mgovet\x1b[0m\tprintf: fmt.Sprintf format %d reads arg #1, but call has 0 args\n" +
+		"\x1b[1mmain.go:15:9\x1b[0m\t\x1b[2merrcheck\x1b[0m\tError return value of `os.Open` is not checked\n"
+	p, err := New(&Option{FormatName: "golangci-lint-tab", StripANSI: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(iotest.OneByteReader(strings.NewReader(sample)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Diagnostic{
+		{
+			Message: "printf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+			Location: &rdf.Location{
+				Path:  "main.go",
+				Range: &rdf.Range{Start: &rdf.Position{Line: 13, Column: 2}},
+			},
+			Severity:       rdf.Severity_ERROR,
+			Code:           &rdf.Code{Value: "govet"},
+			Format:         "golangci-lint-tab",
+			OriginalOutput: "main.go:13:2\terror\tgovet\tprintf: fmt.Sprintf format %d reads arg #1, but call has 0 args",
+		},
+		{
+			Message: "Error return value of `os.Open` is not checked",
+			Location: &rdf.Location{
+				Path:  "main.go",
+				Range: &rdf.Range{Start: &rdf.Position{Line: 15, Column: 9}},
+			},
+			Code:           &rdf.Code{Value: "errcheck"},
+			Format:         "golangci-lint-tab",
+			OriginalOutput: "main.go:15:9\terrcheck\tError return value of `os.Open` is not checked",
+		},
+	}
+	if diff := cmp.Diff(want, ds, protocmp.Transform()); diff != "" {
+		t.Errorf("diagnostics (-want +got):\n%s", diff)
+	}
+}
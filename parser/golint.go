@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GolintParser{}
+
+// GolintParser is parser for golint-style output (path:line: message).
+// Column is optional as old golint doesn't report it.
+// https://github.com/golang/lint
+type GolintParser struct{}
+
+// NewGolintParser returns a new GolintParser.
+func NewGolintParser() *GolintParser {
+	return &GolintParser{}
+}
+
+// path:line[:col]: message
+var golintRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?: (.*)$`)
+
+// Parse parses golint output. Diagnostics without column are reported with
+// unset column rather than column 0.
+func (p *GolintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := golintRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		pos := &rdf.Position{Line: int32(lnum)}
+		if m[3] != "" {
+			col, _ := strconv.Atoi(m[3])
+			pos.Column = int32(col)
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path:  m[1],
+				Range: &rdf.Range{Start: pos},
+			},
+			Message:        m[4],
+			Severity:       rdf.Severity_WARNING,
+			Format:         "golint-nocol",
+			OriginalOutput: s.Text(),
+		})
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGolintParser() {
+	const sample = `golint.go:3: exported function Foo should have comment or be unexported
+golint.go:7: don't use underscores in Go names; var foo_bar should be fooBar
+golint2.go:5:1: exported type Bar should have comment or be unexported
+`
+
+	p := NewGolintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "exported function Foo should have comment or be unexported",
+	//   "location": {
+	//     "path": "golint.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "golint.go:3: exported function Foo should have comment or be unexported",
+	//   "format": "golint-nocol"
+	// }
+	// {
+	//   "message": "don't use underscores in Go names; var foo_bar should be fooBar",
+	//   "location": {
+	//     "path": "golint.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 7
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "golint.go:7: don't use underscores in Go names; var foo_bar should be fooBar",
+	//   "format": "golint-nocol"
+	// }
+	// {
+	//   "message": "exported type Bar should have comment or be unexported",
+	//   "location": {
+	//     "path": "golint2.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 5,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "golint2.go:5:1: exported type Bar should have comment or be unexported",
+	//   "format": "golint-nocol"
+	// }
+}
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &GosecParser{}
+
+// lnum: source
+var gosecCodeLineRe = regexp.MustCompile(`^\d+: ?`)
+
+// GosecParser is parser for gosec JSON output (gosec -fmt=json).
+// https://github.com/securego/gosec
+type GosecParser struct{}
+
+// NewGosecParser returns a new GosecParser.
+func NewGosecParser() *GosecParser {
+	return &GosecParser{}
+}
+
+// Parse parses gosec JSON output. Source lines of issues are stored in Lines
+// without line number prefixes, and confidences in Confidence.
+func (p *GosecParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result GosecResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode gosec JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, issue := range result.Issues {
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path:  issue.File,
+				Range: gosecRange(issue.Line, issue.Column),
+			},
+			Message:    issue.Details,
+			Severity:   ParseSeverity(issue.Severity),
+			Lines:      gosecLines(issue.Code),
+			Confidence: strings.ToUpper(issue.Confidence),
+			Format:     "gosec-json",
+			OriginalOutput: fmt.Sprintf("[%s:%s] - %s (CWE-%s): %s (Confidence: %s, Severity: %s)",
+				issue.File, issue.Line, issue.RuleID, issue.CWE.ID, issue.Details, issue.Confidence, issue.Severity),
+		}
+		if issue.RuleID != "" {
+			d.Code = &rdf.Code{Value: issue.RuleID, Url: issue.CWE.URL}
+			if d.Code.Url == "" && issue.CWE.ID != "" {
+				d.Code.Url = fmt.Sprintf("https://cwe.mitre.org/data/definitions/%s.html", issue.CWE.ID)
+			}
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// gosecRange returns the range of line (e.g. "10" or "10-12") and column.
+func gosecRange(line, column string) *rdf.Range {
+	startLine, endLine := line, ""
+	if i := strings.IndexByte(line, '-'); i >= 0 {
+		startLine, endLine = line[:i], line[i+1:]
+	}
+	start, err := strconv.Atoi(startLine)
+	if err != nil || start <= 0 {
+		return nil
+	}
+	col, _ := strconv.Atoi(column)
+	rng := &rdf.Range{Start: &rdf.Position{Line: int32(start), Column: int32(col)}}
+	if end, err := strconv.Atoi(endLine); err == nil && end > start {
+		rng.End = &rdf.Position{Line: int32(end)}
+	}
+	return rng
+}
+
+func gosecLines(code string) []string {
+	code = strings.TrimRight(code, "\n")
+	if code == "" {
+		return nil
+	}
+	lines := strings.Split(code, "\n")
+	for i, l := range lines {
+		lines[i] = gosecCodeLineRe.ReplaceAllString(l, "")
+	}
+	return lines
+}
+
+// GosecResult represents gosec JSON output.
+// {"Issues":[{"severity":"MEDIUM","confidence":"HIGH","cwe":{"id":"703","url":"https://cwe.mitre.org/data/definitions/703.html"},"rule_id":"G104","details":"Errors unhandled.","file":"main.go","code":"14: \tos.Remove(path)\n","line":"14","column":"2"}]}
+type GosecResult struct {
+	Issues []*GosecIssue `json:"Issues"`
+}
+
+// GosecIssue represents an issue. Line is a line number or a range of lines
+// (e.g. "10-12").
+type GosecIssue struct {
+	Severity   string   `json:"severity"`
+	Confidence string   `json:"confidence"`
+	CWE        GosecCWE `json:"cwe"`
+	RuleID     string   `json:"rule_id"`
+	Details    string   `json:"details"`
+	File       string   `json:"file"`
+	Code       string   `json:"code"`
+	Line       string   `json:"line"`
+	Column     string   `json:"column"`
+}
+
+// GosecCWE represents a CWE of an issue.
+type GosecCWE struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
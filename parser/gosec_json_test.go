@@ -0,0 +1,109 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleGosecParser() {
+	// gosec -fmt=json ./...
+	const sample = `{
+	"Golang errors": {},
+	"Issues": [
+		{
+			"severity": "MEDIUM",
+			"confidence": "HIGH",
+			"cwe": {"id": "703", "url": "https://cwe.mitre.org/data/definitions/703.html"},
+			"rule_id": "G104",
+			"details": "Errors unhandled.",
+			"file": "main.go",
+			"code": "13: \tf, _ := os.Create(path)\n14: \tos.Remove(path)\n15: }\n",
+			"line": "14",
+			"column": "2",
+			"nosec": false,
+			"suppressions": null
+		},
+		{
+			"severity": "HIGH",
+			"confidence": "MEDIUM",
+			"cwe": {"id": "89"},
+			"rule_id": "G202",
+			"details": "SQL string concatenation",
+			"file": "db/query.go",
+			"code": "20: \tq := \"SELECT * FROM users WHERE name = '\" +\n21: \t\tname + \"'\"\n",
+			"line": "20-21",
+			"column": "7"
+		}
+	],
+	"Stats": {"files": 2, "lines": 120, "nosec": 0, "found": 2}
+}`
+
+	p := NewGosecParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Errors unhandled.",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 14,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "G104",
+	//     "url": "https://cwe.mitre.org/data/definitions/703.html"
+	//   },
+	//   "originalOutput": "[main.go:14] - G104 (CWE-703): Errors unhandled. (Confidence: HIGH, Severity: MEDIUM)",
+	//   "format": "gosec-json",
+	//   "lines": [
+	//     "\tf, _ := os.Create(path)",
+	//     "\tos.Remove(path)",
+	//     "}"
+	//   ],
+	//   "confidence": "HIGH"
+	// }
+	// {
+	//   "message": "SQL string concatenation",
+	//   "location": {
+	//     "path": "db/query.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 20,
+	//         "column": 7
+	//       },
+	//       "end": {
+	//         "line": 21
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "G202",
+	//     "url": "https://cwe.mitre.org/data/definitions/89.html"
+	//   },
+	//   "originalOutput": "[db/query.go:20-21] - G202 (CWE-89): SQL string concatenation (Confidence: MEDIUM, Severity: HIGH)",
+	//   "format": "gosec-json",
+	//   "lines": [
+	//     "\tq := \"SELECT * FROM users WHERE name = '\" +",
+	//     "\t\tname + \"'\""
+	//   ],
+	//   "confidence": "MEDIUM"
+	// }
+}
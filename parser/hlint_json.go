@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &HlintParser{}
+
+// HlintParser is parser for hlint JSON output (hlint --json).
+// https://github.com/ndmitchell/hlint
+type HlintParser struct{}
+
+// NewHlintParser returns a new HlintParser.
+func NewHlintParser() *HlintParser {
+	return &HlintParser{}
+}
+
+// Parse parses hlint JSON output. Ideas with replacements are reported with
+// suggestions replacing the whole range.
+func (p *HlintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ideas []*HlintIdea
+	if err := json.NewDecoder(r).Decode(&ideas); err != nil {
+		return nil, fmt.Errorf("failed to decode hlint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, idea := range ideas {
+		msg := idea.Hint
+		if len(idea.Note) > 0 {
+			msg += "\nNote: " + strings.Join(idea.Note, "\n")
+		}
+		rng := &rdf.Range{
+			Start: &rdf.Position{Line: int32(idea.StartLine), Column: int32(idea.StartColumn)},
+			End:   &rdf.Position{Line: int32(idea.EndLine), Column: int32(idea.EndColumn)},
+		}
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: idea.File, Range: rng},
+			Message:        msg,
+			Severity:       ParseSeverity(idea.Severity),
+			Format:         "hlint-json",
+			OriginalOutput: fmt.Sprintf("%s:%d:%d-%d: %s: %s", idea.File, idea.StartLine, idea.StartColumn, idea.EndColumn, idea.Severity, idea.Hint),
+		}
+		if idea.Hint != "" {
+			d.Code = &rdf.Code{Value: idea.Hint}
+		}
+		if idea.To != nil {
+			// Don't share the range with the location, which steps may modify.
+			d.Suggestions = []*rdf.Suggestion{{Range: proto.Clone(rng).(*rdf.Range), Text: *idea.To}}
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// HlintIdea represents an idea (hint) of hlint JSON output. Lines and columns
+// are 1-based and end columns are exclusive. To is nil if there is no
+// replacement.
+// [{"module":["Main"],"decl":["main"],"severity":"Warning","hint":"Use concatMap","file":"src/Main.hs","startLine":5,"startColumn":8,"endLine":5,"endColumn":26,"from":"concat (map f xs)","to":"concatMap f xs","note":[],"refactorings":"..."}]
+type HlintIdea struct {
+	Module      []string `json:"module"`
+	Decl        []string `json:"decl"`
+	Severity    string   `json:"severity"`
+	Hint        string   `json:"hint"`
+	File        string   `json:"file"`
+	StartLine   int      `json:"startLine"`
+	StartColumn int      `json:"startColumn"`
+	EndLine     int      `json:"endLine"`
+	EndColumn   int      `json:"endColumn"`
+	From        string   `json:"from"`
+	To          *string  `json:"to"`
+	Note        []string `json:"note"`
+}
@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleHlintParser() {
+	// hlint --json src
+	const sample = `[{"module":["Main"],"decl":["main"],"severity":"Warning","hint":"Use concatMap","file":"src/Main.hs","startLine":5,"startColumn":8,"endLine":5,"endColumn":26,"from":"concat (map f xs)","to":"concatMap f xs","note":[],"refactorings":"[Replace {rtype = Expr, pos = SrcSpan {startLine = 5, startCol = 8, endLine = 5, endCol = 26}, subts = [(\"f\",SrcSpan {startLine = 5, startCol = 20, endLine = 5, endCol = 21})], orig = \"concatMap f xs\"}]"}
+,{"module":["Lib"],"decl":["go"],"severity":"Suggestion","hint":"Eta reduce","file":"src/Lib.hs","startLine":10,"startColumn":1,"endLine":10,"endColumn":14,"from":"go x = g x","to":"go = g","note":["increases laziness"],"refactorings":"[]"}
+,{"module":["Lib"],"decl":[],"severity":"Error","hint":"Parse error: possibly incorrect indentation","file":"src/Bad.hs","startLine":3,"startColumn":1,"endLine":3,"endColumn":2,"from":"","to":null,"note":[],"refactorings":"[]"}
+]`
+
+	p := NewHlintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Use concatMap",
+	//   "location": {
+	//     "path": "src/Main.hs",
+	//     "range": {
+	//       "start": {
+	//         "line": 5,
+	//         "column": 8
+	//       },
+	//       "end": {
+	//         "line": 5,
+	//         "column": 26
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "Use concatMap"
+	//   },
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 5,
+	//           "column": 8
+	//         },
+	//         "end": {
+	//           "line": 5,
+	//           "column": 26
+	//         }
+	//       },
+	//       "text": "concatMap f xs"
+	//     }
+	//   ],
+	//   "originalOutput": "src/Main.hs:5:8-26: Warning: Use concatMap",
+	//   "format": "hlint-json"
+	// }
+	// {
+	//   "message": "Eta reduce\nNote: increases laziness",
+	//   "location": {
+	//     "path": "src/Lib.hs",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 10,
+	//         "column": 14
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "Eta reduce"
+	//   },
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 10,
+	//           "column": 1
+	//         },
+	//         "end": {
+	//           "line": 10,
+	//           "column": 14
+	//         }
+	//       },
+	//       "text": "go = g"
+	//     }
+	//   ],
+	//   "originalOutput": "src/Lib.hs:10:1-14: Suggestion: Eta reduce",
+	//   "format": "hlint-json"
+	// }
+	// {
+	//   "message": "Parse error: possibly incorrect indentation",
+	//   "location": {
+	//     "path": "src/Bad.hs",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 3,
+	//         "column": 2
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "Parse error: possibly incorrect indentation"
+	//   },
+	//   "originalOutput": "src/Bad.hs:3:1-2: Error: Parse error: possibly incorrect indentation",
+	//   "format": "hlint-json"
+	// }
+}
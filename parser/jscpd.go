@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &JSCPDParser{}
+
+// JSCPDParser is parser for jscpd (copy/paste detector) JSON report
+// (jscpd --reporters json).
+// https://github.com/kucherenko/jscpd
+type JSCPDParser struct{}
+
+// NewJSCPDParser returns a new JSCPDParser.
+func NewJSCPDParser() *JSCPDParser {
+	return &JSCPDParser{}
+}
+
+// Parse parses jscpd JSON report. Each duplicate is reported as a warning at
+// the first file with a related location at the second one.
+func (p *JSCPDParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var report JSCPDReport
+	if err := json.NewDecoder(r).Decode(&report); err != nil {
+		return nil, fmt.Errorf("failed to decode jscpd JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, dup := range report.Duplicates {
+		first, second := dup.FirstFile, dup.SecondFile
+		msg := fmt.Sprintf("%d duplicated lines with %s:%d-%d", dup.Lines, second.Name, second.Start, second.End)
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{Path: first.Name, Range: first.rdfRange()},
+			Message:  msg,
+			Severity: rdf.Severity_WARNING,
+			RelatedLocations: []*rdf.RelatedLocation{{
+				Message:  "duplicated lines",
+				Location: &rdf.Location{Path: second.Name, Range: second.rdfRange()},
+			}},
+			Format:         "jscpd",
+			OriginalOutput: fmt.Sprintf("%s:%d-%d: %s", first.Name, first.Start, first.End, msg),
+		})
+	}
+	return ds, nil
+}
+
+// JSCPDReport represents jscpd JSON report.
+// {"duplicates":[{"format":"javascript","lines":12,"fragment":"...","firstFile":{"name":"src/a.js","start":1,"end":12},"secondFile":{"name":"src/b.js","start":20,"end":31}}]}
+type JSCPDReport struct {
+	Duplicates []*JSCPDDuplicate `json:"duplicates"`
+}
+
+// JSCPDDuplicate represents a pair of duplicated fragments.
+type JSCPDDuplicate struct {
+	Format     string    `json:"format"`
+	Lines      int       `json:"lines"`
+	Fragment   string    `json:"fragment"`
+	FirstFile  JSCPDFile `json:"firstFile"`
+	SecondFile JSCPDFile `json:"secondFile"`
+}
+
+// JSCPDFile represents a duplicated fragment of a file. Start and end lines
+// are 1-based and inclusive.
+type JSCPDFile struct {
+	Name  string `json:"name"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+func (f JSCPDFile) rdfRange() *rdf.Range {
+	rng := &rdf.Range{Start: &rdf.Position{Line: int32(f.Start)}}
+	if f.End > f.Start {
+		rng.End = &rdf.Position{Line: int32(f.End)}
+	}
+	return rng
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleJSCPDParser() {
+	// jscpd --reporters json --output . src && cat jscpd-report.json
+	const sample = `{
+  "statistics": {"total": {"lines": 120, "duplicatedLines": 12}},
+  "duplicates": [
+    {
+      "format": "javascript",
+      "lines": 12,
+      "fragment": "function add(a, b) {\n  return a + b;\n}",
+      "tokens": 0,
+      "firstFile": {"name": "src/a.js", "start": 3, "end": 14},
+      "secondFile": {"name": "src/b.js", "start": 20, "end": 31}
+    }
+  ]
+}`
+
+	p := NewJSCPDParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "12 duplicated lines with src/b.js:20-31",
+	//   "location": {
+	//     "path": "src/a.js",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       },
+	//       "end": {
+	//         "line": 14
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "src/a.js:3-14: 12 duplicated lines with src/b.js:20-31",
+	//   "format": "jscpd",
+	//   "relatedLocations": [
+	//     {
+	//       "message": "duplicated lines",
+	//       "location": {
+	//         "path": "src/b.js",
+	//         "range": {
+	//           "start": {
+	//             "line": 20
+	//           },
+	//           "end": {
+	//             "line": 31
+	//           }
+	//         }
+	//       }
+	//     }
+	//   ]
+	// }
+}
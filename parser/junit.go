@@ -0,0 +1,130 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &JUnitParser{}
+
+// JUnitParser is parser for JUnit XML.
+type JUnitParser struct {
+	mapSeverity func(string) rdf.Severity
+}
+
+// NewJUnitParser returns a new JUnitParser.
+func NewJUnitParser() *JUnitParser {
+	return &JUnitParser{}
+}
+
+// path:line:col: message or path:line: message
+var junitMessagePosRe = regexp.MustCompile(`^(\S.*?):(\d+)(?::(\d+))?:\s*(.*)$`)
+
+// Parse parses JUnit XML and returns diagnostics for failures and errors of
+// test cases.
+//
+// Some linters (e.g. golangci-lint) embed position of the problem in the
+// failure message like "path:line:col: message". Such position is used as
+// the location of the diagnostic. Otherwise, the diagnostic is reported on
+// the file of the test case (if any) without range.
+func (p *JUnitParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var suites JUnitTestSuites
+	if err := xml.NewDecoder(r).Decode(&suites); err != nil {
+		return nil, err
+	}
+	var ds []*rdf.Diagnostic
+	for _, suite := range suites.testSuites() {
+		for _, tc := range suite.TestCases {
+			for _, f := range tc.Failures {
+				ds = append(ds, p.buildDiagnostic(tc, f))
+			}
+			for _, f := range tc.Errors {
+				ds = append(ds, p.buildDiagnostic(tc, f))
+			}
+		}
+	}
+	return ds, nil
+}
+
+func (p *JUnitParser) buildDiagnostic(tc *JUnitTestCase, f *JUnitFailure) *rdf.Diagnostic {
+	msg := f.Message
+	if msg == "" {
+		msg = strings.TrimSpace(f.Content)
+	}
+	d := &rdf.Diagnostic{
+		Location:       &rdf.Location{Path: tc.File},
+		Message:        msg,
+		Severity:       p.severity(f.Type),
+		Format:         "junit",
+		OriginalOutput: strings.TrimSpace(fmt.Sprintf("%s\n%s", f.Message, f.Content)),
+	}
+	if m := junitMessagePosRe.FindStringSubmatch(msg); m != nil {
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d.Location = &rdf.Location{
+			Path: m[1],
+			Range: &rdf.Range{
+				Start: &rdf.Position{
+					Line:   int32(lnum),
+					Column: int32(col),
+				},
+			},
+		}
+		d.Message = m[4]
+	}
+	if tc.Name != "" {
+		d.Code = &rdf.Code{Value: tc.Name}
+	}
+	return d
+}
+
+func (p *JUnitParser) severity(s string) rdf.Severity {
+	if p.mapSeverity != nil {
+		return p.mapSeverity(s)
+	}
+	return severity(s)
+}
+
+// JUnitTestSuites represents JUnit XML result. The root element can be
+// either <testsuites> or <testsuite>.
+type JUnitTestSuites struct {
+	XMLName    xml.Name
+	TestSuites []*JUnitTestSuite `xml:"testsuite"`
+	TestCases  []*JUnitTestCase  `xml:"testcase"`
+}
+
+func (s *JUnitTestSuites) testSuites() []*JUnitTestSuite {
+	if s.XMLName.Local == "testsuite" {
+		return []*JUnitTestSuite{{TestCases: s.TestCases}}
+	}
+	return s.TestSuites
+}
+
+// JUnitTestSuite represents <testsuite name="name"><testcase ... />...</testsuite>
+type JUnitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	TestCases []*JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitTestCase represents <testcase name="name" classname="classname" file="file"><failure ... /></testcase>
+type JUnitTestCase struct {
+	Name      string          `xml:"name,attr"`
+	ClassName string          `xml:"classname,attr"`
+	File      string          `xml:"file,attr"`
+	Failures  []*JUnitFailure `xml:"failure"`
+	Errors    []*JUnitFailure `xml:"error"`
+}
+
+// JUnitFailure represents <failure message="msg" type="type">content</failure>
+// or <error message="msg" type="type">content</error>.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
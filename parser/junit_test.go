@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleJUnitParser() {
+	// golangci-lint run --out-format=junit-xml
+	const sample = `<testsuites>
+  <testsuite name="pkg/foo/foo.go" tests="2" errors="0" failures="2">
+    <testcase name="errcheck" classname="pkg/foo/foo.go:15:9">
+      <failure message="pkg/foo/foo.go:15:9: Error return value of ` + "`os.Open`" + ` is not checked" type=""><![CDATA[: Error return value of ` + "`os.Open`" + ` is not checked
+Category: errcheck
+File: pkg/foo/foo.go
+Line: 15
+Details: 	os.Open("abc")]]></failure>
+    </testcase>
+    <testcase name="golint" classname="pkg/foo/foo.go:20">
+      <failure message="pkg/foo/foo.go:20: exported function Foo should have comment or be unexported" type="warning"><![CDATA[warning: exported function Foo should have comment or be unexported
+Category: golint
+File: pkg/foo/foo.go
+Line: 20
+Details: func Foo() {}]]></failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="other" tests="1" errors="1" failures="0">
+    <testcase name="TestSomething" classname="other" file="other_test.go">
+      <error message="panic: runtime error" type="error"></error>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	p := NewJUnitParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of `os.Open` is not checked",
+	//   "location": {
+	//     "path": "pkg/foo/foo.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15,
+	//         "column": 9
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "pkg/foo/foo.go:15:9: Error return value of `os.Open` is not checked\n: Error return value of `os.Open` is not checked\nCategory: errcheck\nFile: pkg/foo/foo.go\nLine: 15\nDetails: \tos.Open(\"abc\")",
+	//   "format": "junit"
+	// }
+	// {
+	//   "message": "exported function Foo should have comment or be unexported",
+	//   "location": {
+	//     "path": "pkg/foo/foo.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 20
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "golint"
+	//   },
+	//   "originalOutput": "pkg/foo/foo.go:20: exported function Foo should have comment or be unexported\nwarning: exported function Foo should have comment or be unexported\nCategory: golint\nFile: pkg/foo/foo.go\nLine: 20\nDetails: func Foo() {}",
+	//   "format": "junit"
+	// }
+	// {
+	//   "message": "panic: runtime error",
+	//   "location": {
+	//     "path": "other_test.go"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "TestSomething"
+	//   },
+	//   "originalOutput": "panic: runtime error",
+	//   "format": "junit"
+	// }
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &KicsParser{}
+
+// KicsParser is parser for KICS JSON output (kics scan --report-formats json).
+// https://github.com/Checkmarx/kics
+type KicsParser struct{}
+
+// NewKicsParser returns a new KicsParser.
+func NewKicsParser() *KicsParser {
+	return &KicsParser{}
+}
+
+// Parse parses KICS JSON output. Each file of queries is reported as a
+// diagnostic.
+func (p *KicsParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result KicsResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode kics JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, q := range result.Queries {
+		for _, f := range q.Files {
+			msg := q.QueryName
+			if f.ExpectedValue != "" {
+				msg += fmt.Sprintf("\nExpected: %s\nActual: %s", f.ExpectedValue, f.ActualValue)
+			}
+			d := &rdf.Diagnostic{
+				Location:       &rdf.Location{Path: f.FileName},
+				Message:        msg,
+				Severity:       ParseSeverity(q.Severity),
+				Fingerprint:    f.SimilarityID,
+				Format:         "kics",
+				OriginalOutput: fmt.Sprintf("%s:%d: [%s] %s (%s)", f.FileName, f.Line, q.Severity, q.QueryName, q.QueryID),
+			}
+			if f.Line > 0 {
+				d.Location.Range = &rdf.Range{Start: &rdf.Position{Line: int32(f.Line)}}
+			}
+			if q.QueryID != "" {
+				d.Code = &rdf.Code{Value: q.QueryID, Url: q.QueryURL}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+// KicsResult represents KICS JSON output.
+// {"queries":[{"query_name":"Missing User Instruction","query_id":"fd54f200-402c-4333-a5a4-36ef6709af2f","query_url":"https://docs.docker.com/engine/reference/builder/#user","severity":"HIGH","files":[{"file_name":"Dockerfile","similarity_id":"...","line":1,"expected_value":"The 'Dockerfile' should contain the 'USER' instruction","actual_value":"The 'Dockerfile' does not contain any 'USER' instruction"}]}]}
+type KicsResult struct {
+	Queries []*KicsQuery `json:"queries"`
+}
+
+// KicsQuery represents a query (rule) and files which violate it.
+type KicsQuery struct {
+	QueryName string      `json:"query_name"`
+	QueryID   string      `json:"query_id"`
+	QueryURL  string      `json:"query_url"`
+	Severity  string      `json:"severity"`
+	Files     []*KicsFile `json:"files"`
+}
+
+// KicsFile represents a violation in a file. Line is 1-based.
+type KicsFile struct {
+	FileName      string `json:"file_name"`
+	SimilarityID  string `json:"similarity_id"`
+	Line          int    `json:"line"`
+	ExpectedValue string `json:"expected_value"`
+	ActualValue   string `json:"actual_value"`
+}
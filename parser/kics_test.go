@@ -0,0 +1,131 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleKicsParser() {
+	// kics scan -p . --report-formats json -o ./out
+	const sample = `{
+  "kics_version": "v1.7.0",
+  "files_scanned": 2,
+  "queries_total": 2,
+  "queries": [
+    {
+      "query_name": "Missing User Instruction",
+      "query_id": "fd54f200-402c-4333-a5a4-36ef6709af2f",
+      "query_url": "https://docs.docker.com/engine/reference/builder/#user",
+      "severity": "HIGH",
+      "platform": "Dockerfile",
+      "category": "Build Process",
+      "description": "A user should be specified in the dockerfile, otherwise the image will run as root",
+      "files": [
+        {
+          "file_name": "Dockerfile",
+          "similarity_id": "b84a0b47f2e21616bd3ace0ed3b4d7ad2e7f5cc8fbd3a4c1b5f1e6eb1d2c4c2d",
+          "line": 1,
+          "resource_type": "",
+          "resource_name": "",
+          "issue_type": "MissingAttribute",
+          "search_key": "FROM={{golang:1.20}}",
+          "expected_value": "The 'Dockerfile' should contain the 'USER' instruction",
+          "actual_value": "The 'Dockerfile' does not contain any 'USER' instruction"
+        }
+      ]
+    },
+    {
+      "query_name": "Healthcheck Instruction Missing",
+      "query_id": "b03a748a-542d-44f4-bb86-9199ab4fd2d5",
+      "query_url": "https://docs.docker.com/engine/reference/builder/#healthcheck",
+      "severity": "LOW",
+      "files": [
+        {
+          "file_name": "Dockerfile",
+          "similarity_id": "3ab1c5c6a4b8d0f1e2c3b4a5968778695a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d",
+          "line": 1
+        },
+        {
+          "file_name": "build/Dockerfile",
+          "similarity_id": "9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c8b7a6f5e4d3c2b1a0f9e8d",
+          "line": 3
+        }
+      ]
+    }
+  ]
+}`
+
+	p := NewKicsParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Missing User Instruction\nExpected: The 'Dockerfile' should contain the 'USER' instruction\nActual: The 'Dockerfile' does not contain any 'USER' instruction",
+	//   "location": {
+	//     "path": "Dockerfile",
+	//     "range": {
+	//       "start": {
+	//         "line": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "fd54f200-402c-4333-a5a4-36ef6709af2f",
+	//     "url": "https://docs.docker.com/engine/reference/builder/#user"
+	//   },
+	//   "originalOutput": "Dockerfile:1: [HIGH] Missing User Instruction (fd54f200-402c-4333-a5a4-36ef6709af2f)",
+	//   "fingerprint": "b84a0b47f2e21616bd3ace0ed3b4d7ad2e7f5cc8fbd3a4c1b5f1e6eb1d2c4c2d",
+	//   "format": "kics"
+	// }
+	// {
+	//   "message": "Healthcheck Instruction Missing",
+	//   "location": {
+	//     "path": "Dockerfile",
+	//     "range": {
+	//       "start": {
+	//         "line": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "b03a748a-542d-44f4-bb86-9199ab4fd2d5",
+	//     "url": "https://docs.docker.com/engine/reference/builder/#healthcheck"
+	//   },
+	//   "originalOutput": "Dockerfile:1: [LOW] Healthcheck Instruction Missing (b03a748a-542d-44f4-bb86-9199ab4fd2d5)",
+	//   "fingerprint": "3ab1c5c6a4b8d0f1e2c3b4a5968778695a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d",
+	//   "format": "kics"
+	// }
+	// {
+	//   "message": "Healthcheck Instruction Missing",
+	//   "location": {
+	//     "path": "build/Dockerfile",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "b03a748a-542d-44f4-bb86-9199ab4fd2d5",
+	//     "url": "https://docs.docker.com/engine/reference/builder/#healthcheck"
+	//   },
+	//   "originalOutput": "build/Dockerfile:3: [LOW] Healthcheck Instruction Missing (b03a748a-542d-44f4-bb86-9199ab4fd2d5)",
+	//   "fingerprint": "9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c8b7a6f5e4d3c2b1a0f9e8d",
+	//   "format": "kics"
+	// }
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &KtlintParser{}
+
+// KtlintParser is parser for ktlint JSON output (ktlint --reporter=json).
+// https://pinterest.github.io/ktlint/
+type KtlintParser struct{}
+
+// NewKtlintParser returns a new KtlintParser.
+func NewKtlintParser() *KtlintParser {
+	return &KtlintParser{}
+}
+
+// Parse parses ktlint JSON output. ktlint reports all violations as errors.
+func (p *KtlintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var files []*KtlintFile
+	if err := json.NewDecoder(r).Decode(&files); err != nil {
+		return nil, fmt.Errorf("failed to decode ktlint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, f := range files {
+		for _, e := range f.Errors {
+			d := &rdf.Diagnostic{
+				Location: &rdf.Location{
+					Path: f.File,
+					Range: &rdf.Range{
+						Start: &rdf.Position{Line: int32(e.Line), Column: int32(e.Column)},
+					},
+				},
+				Message:        e.Message,
+				Severity:       rdf.Severity_ERROR,
+				Format:         "ktlint",
+				OriginalOutput: fmt.Sprintf("%s:%d:%d: %s (%s)", f.File, e.Line, e.Column, e.Message, e.Rule),
+			}
+			if e.Rule != "" {
+				d.Code = &rdf.Code{Value: e.Rule}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+// KtlintFile represents errors of a file of ktlint JSON output.
+// [{"file":"src/main/kotlin/Main.kt","errors":[{"line":1,"column":1,"message":"Wildcard import","rule":"standard:no-wildcard-imports"}]}]
+type KtlintFile struct {
+	File   string         `json:"file"`
+	Errors []*KtlintError `json:"errors"`
+}
+
+// KtlintError represents an error. Lines and columns are 1-based.
+type KtlintError struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Message string `json:"message"`
+	Rule    string `json:"rule"`
+}
@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleKtlintParser() {
+	// ktlint --reporter=json "src/**/*.kt"
+	const sample = `[
+	{
+		"file": "src/main/kotlin/com/example/App.kt",
+		"errors": [
+			{
+				"line": 3,
+				"column": 1,
+				"message": "Wildcard import",
+				"rule": "standard:no-wildcard-imports"
+			},
+			{
+				"line": 12,
+				"column": 26,
+				"message": "Missing trailing comma before \")\"",
+				"rule": "standard:trailing-comma-on-declaration-site"
+			}
+		]
+	},
+	{
+		"file": "src/main/kotlin/com/example/Util.kt",
+		"errors": [
+			{
+				"line": 7,
+				"column": 5,
+				"message": "Unexpected indentation (4) (should be 8)",
+				"rule": "standard:indent"
+			}
+		]
+	}
+]`
+
+	p := NewKtlintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Wildcard import",
+	//   "location": {
+	//     "path": "src/main/kotlin/com/example/App.kt",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "standard:no-wildcard-imports"
+	//   },
+	//   "originalOutput": "src/main/kotlin/com/example/App.kt:3:1: Wildcard import (standard:no-wildcard-imports)",
+	//   "format": "ktlint"
+	// }
+	// {
+	//   "message": "Missing trailing comma before \")\"",
+	//   "location": {
+	//     "path": "src/main/kotlin/com/example/App.kt",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 26
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "standard:trailing-comma-on-declaration-site"
+	//   },
+	//   "originalOutput": "src/main/kotlin/com/example/App.kt:12:26: Missing trailing comma before \")\" (standard:trailing-comma-on-declaration-site)",
+	//   "format": "ktlint"
+	// }
+	// {
+	//   "message": "Unexpected indentation (4) (should be 8)",
+	//   "location": {
+	//     "path": "src/main/kotlin/com/example/Util.kt",
+	//     "range": {
+	//       "start": {
+	//         "line": 7,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "standard:indent"
+	//   },
+	//   "originalOutput": "src/main/kotlin/com/example/Util.kt:7:5: Unexpected indentation (4) (should be 8) (standard:indent)",
+	//   "format": "ktlint"
+	// }
+}
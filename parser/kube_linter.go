@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &KubeLinterParser{}
+
+// KubeLinterParser is parser for kube-linter JSON output
+// (kube-linter lint --format=json).
+// https://github.com/stackrox/kube-linter
+type KubeLinterParser struct {
+	preserveRaw bool
+}
+
+// NewKubeLinterParser returns a new KubeLinterParser.
+func NewKubeLinterParser() *KubeLinterParser {
+	return &KubeLinterParser{}
+}
+
+// Parse parses kube-linter JSON output. Reports are file-level diagnostics
+// as kube-linter doesn't report positions.
+func (p *KubeLinterParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result KubeLinterResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode kube-linter JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, report := range result.Reports {
+		path := report.Object.Metadata.FilePath
+		obj := report.Object.K8sObject
+		msg := report.Diagnostic.Message
+		if obj.Name != "" {
+			msg = fmt.Sprintf("%s (object: %s/%s %s)", msg, obj.Namespace, obj.Name, obj.GroupVersionKind.Kind)
+		}
+		if report.Remediation != "" {
+			msg += "\nRemediation: " + report.Remediation
+		}
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: path},
+			Message:        msg,
+			Severity:       rdf.Severity_ERROR,
+			Format:         "kube-linter",
+			OriginalOutput: fmt.Sprintf("%s: %s (check: %s)", path, report.Diagnostic.Message, report.Check),
+		}
+		if report.Check != "" {
+			d.Code = &rdf.Code{Value: report.Check}
+		}
+		if p.preserveRaw {
+			d.Raw = report.raw
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// KubeLinterResult represents kube-linter JSON output.
+// {"Reports":[{"Diagnostic":{"Message":"msg"},"Check":"no-read-only-root-fs","Remediation":"...","Object":{"Metadata":{"FilePath":"deploy.yaml"},"K8sObject":{"Namespace":"default","Name":"app","GroupVersionKind":{"Group":"apps","Version":"v1","Kind":"Deployment"}}}}]}
+type KubeLinterResult struct {
+	Reports []*KubeLinterReport `json:"Reports"`
+}
+
+// KubeLinterReport represents a failed check of an object.
+type KubeLinterReport struct {
+	Diagnostic struct {
+		Message string `json:"Message"`
+	} `json:"Diagnostic"`
+	Check       string           `json:"Check"`
+	Remediation string           `json:"Remediation"`
+	Object      KubeLinterObject `json:"Object"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler to keep the raw JSON.
+func (r *KubeLinterReport) UnmarshalJSON(b []byte) error {
+	type report KubeLinterReport // Avoid recursion.
+	return unmarshalRaw(b, (*report)(r), &r.raw)
+}
+
+// KubeLinterObject represents a Kubernetes object and the file defining it.
+type KubeLinterObject struct {
+	Metadata struct {
+		FilePath string `json:"FilePath"`
+	} `json:"Metadata"`
+	K8sObject struct {
+		Namespace        string `json:"Namespace"`
+		Name             string `json:"Name"`
+		GroupVersionKind struct {
+			Kind string `json:"Kind"`
+		} `json:"GroupVersionKind"`
+	} `json:"K8sObject"`
+}
@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleKubeLinterParser() {
+	// kube-linter lint --format=json deploy/
+	const sample = `{
+  "Checks": [{"name": "no-read-only-root-fs", "description": "Indicates when containers are running without a read-only root filesystem."}],
+  "Reports": [
+    {
+      "Diagnostic": {"Message": "container \"app\" does not have a read-only root file system"},
+      "Check": "no-read-only-root-fs",
+      "Remediation": "Set readOnlyRootFilesystem to true in the container securityContext.",
+      "Object": {
+        "Metadata": {"FilePath": "deploy/deployment.yaml"},
+        "K8sObject": {
+          "Namespace": "default",
+          "Name": "app",
+          "GroupVersionKind": {"Group": "apps", "Version": "v1", "Kind": "Deployment"}
+        }
+      }
+    }
+  ],
+  "Summary": {"ChecksStatus": "Failed", "KubeLinterVersion": "0.1.4"}
+}`
+
+	p := NewKubeLinterParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "container \"app\" does not have a read-only root file system (object: default/app Deployment)\nRemediation: Set readOnlyRootFilesystem to true in the container securityContext.",
+	//   "location": {
+	//     "path": "deploy/deployment.yaml"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "no-read-only-root-fs"
+	//   },
+	//   "originalOutput": "deploy/deployment.yaml: container \"app\" does not have a read-only root file system (check: no-read-only-root-fs)",
+	//   "format": "kube-linter"
+	// }
+}
@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &MarkdownLinkCheckParser{}
+
+// MarkdownLinkCheckParser is parser for markdown-link-check JSON output.
+// https://github.com/tcort/markdown-link-check
+type MarkdownLinkCheckParser struct {
+	path string
+	fr   FileReader
+}
+
+// NewMarkdownLinkCheckParser returns a new MarkdownLinkCheckParser.
+// markdown-link-check checks one file at a time and doesn't report the path,
+// so the checked file path should be given. If fr is not nil, it's used to
+// find the line of each dead link in the file.
+func NewMarkdownLinkCheckParser(path string, fr FileReader) *MarkdownLinkCheckParser {
+	return &MarkdownLinkCheckParser{path: path, fr: fr}
+}
+
+// Parse parses markdown-link-check JSON output and returns diagnostics for
+// dead links.
+func (p *MarkdownLinkCheckParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result MarkdownLinkCheckResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode markdown-link-check JSON: %w", err)
+	}
+	var content []byte
+	if p.fr != nil && p.path != "" {
+		// Locating links is best-effort. Report dead links without line numbers
+		// if the file is unavailable.
+		content, _ = p.fr.ReadFile(p.path)
+	}
+	var ds []*rdf.Diagnostic
+	for _, link := range result.Links {
+		if link.Status != "dead" {
+			continue
+		}
+		msg := fmt.Sprintf("dead link: %s (%s)", link.Link, linkStatus(link))
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: p.path},
+			Message:        msg,
+			Severity:       rdf.Severity_WARNING,
+			Format:         "markdown-link-check",
+			OriginalOutput: fmt.Sprintf("%s: %s", p.path, msg),
+		}
+		if link.StatusCode != 0 {
+			d.Code = &rdf.Code{Value: strconv.Itoa(link.StatusCode)}
+		}
+		if pos := findPosition(content, link.Link); pos != nil {
+			d.Location.Range = &rdf.Range{Start: pos}
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+func linkStatus(link *MarkdownLinkCheckLink) string {
+	if link.StatusCode != 0 {
+		return fmt.Sprintf("status code: %d", link.StatusCode)
+	}
+	if link.Err != nil && string(link.Err) != "null" {
+		return fmt.Sprintf("error: %s", link.Err)
+	}
+	return link.Status
+}
+
+// findPosition returns the position of the first occurrence of s in content,
+// or nil if not found.
+func findPosition(content []byte, s string) *rdf.Position {
+	if len(content) == 0 || s == "" {
+		return nil
+	}
+	i := bytes.Index(content, []byte(s))
+	if i < 0 {
+		return nil
+	}
+	return offsetPosition(content, i)
+}
+
+// offsetPosition returns the position of the byte offset in content, or nil
+// if the offset is out of content. Columns are 1-based byte columns.
+func offsetPosition(content []byte, offset int) *rdf.Position {
+	if offset < 0 || offset > len(content) {
+		return nil
+	}
+	lineStart := bytes.LastIndexByte(content[:offset], '\n') + 1
+	return &rdf.Position{
+		Line:   int32(bytes.Count(content[:offset], []byte("\n")) + 1),
+		Column: int32(offset - lineStart + 1),
+	}
+}
+
+// MarkdownLinkCheckResult represents markdown-link-check JSON result.
+// {"links":[{"link":"https://example.com","status":"dead","statusCode":404,"err":null}]}
+type MarkdownLinkCheckResult struct {
+	Links []*MarkdownLinkCheckLink `json:"links"`
+}
+
+// MarkdownLinkCheckLink represents a checked link.
+type MarkdownLinkCheckLink struct {
+	Link       string `json:"link"`
+	Status     string `json:"status"`
+	StatusCode int    `json:"statusCode"`
+	// Err is an arbitrary error object (or null).
+	Err json.RawMessage `json:"err"`
+}
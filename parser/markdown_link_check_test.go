@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleMarkdownLinkCheckParser() {
+	const sample = `{
+  "links": [
+    {"link": "https://github.com/reviewdog/reviewdog", "status": "alive", "statusCode": 200, "err": null},
+    {"link": "https://example.com/not-found", "status": "dead", "statusCode": 404, "err": null},
+    {"link": "https://unknown.example.com", "status": "dead", "statusCode": 0, "err": {"code": "ENOTFOUND"}},
+    {"link": "https://example.com/ignored", "status": "ignored", "statusCode": 0, "err": null}
+  ]
+}`
+	fr := fakeFileReader{
+		"README.md": "# reviewdog\n\nSee [reviewdog](https://github.com/reviewdog/reviewdog).\nBroken [link](https://example.com/not-found).\n",
+	}
+	p := NewMarkdownLinkCheckParser("README.md", fr)
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "dead link: https://example.com/not-found (status code: 404)",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 4,
+	//         "column": 15
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "404"
+	//   },
+	//   "originalOutput": "README.md: dead link: https://example.com/not-found (status code: 404)",
+	//   "format": "markdown-link-check"
+	// }
+	// {
+	//   "message": "dead link: https://unknown.example.com (error: {\"code\": \"ENOTFOUND\"})",
+	//   "location": {
+	//     "path": "README.md"
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "README.md: dead link: https://unknown.example.com (error: {\"code\": \"ENOTFOUND\"})",
+	//   "format": "markdown-link-check"
+	// }
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &MisspellParser{}
+
+// MisspellParser is parser for misspell output
+// (path:line:col: "teh" is a misspelling of "the").
+// https://github.com/client9/misspell
+type MisspellParser struct{}
+
+// NewMisspellParser returns a new MisspellParser.
+func NewMisspellParser() *MisspellParser {
+	return &MisspellParser{}
+}
+
+// path:line:col: message
+var misspellRe = regexp.MustCompile(`^(.+?):(\d+):(\d+): (.*)$`)
+
+// "wrong" is a misspelling of "right"
+var misspellMsgRe = regexp.MustCompile(`^"([^"]+)" is a misspelling of "([^"]+)"$`)
+
+// Parse parses misspell output. Misspellings are reported with a suggestion
+// which replaces the wrong word with the right one.
+func (p *MisspellParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := misspellRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(lnum), Column: int32(col)},
+				},
+			},
+			Message:        m[4],
+			Severity:       rdf.Severity_INFO,
+			Format:         "misspell",
+			OriginalOutput: s.Text(),
+		}
+		if words := misspellMsgRe.FindStringSubmatch(m[4]); words != nil && col > 0 {
+			d.Suggestions = []*rdf.Suggestion{{
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(lnum), Column: int32(col)},
+					End:   &rdf.Position{Line: int32(lnum), Column: int32(col + len(words[1]))},
+				},
+				Text: words[2],
+			}}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleMisspellParser() {
+	const sample = `README.md:3:10: "langauge" is a misspelling of "language"
+doc/guide.md:12:1: "Recieve" is a misspelling of "Receive"
+`
+
+	p := NewMisspellParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "\"langauge\" is a misspelling of \"language\"",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 10
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 3,
+	//           "column": 10
+	//         },
+	//         "end": {
+	//           "line": 3,
+	//           "column": 18
+	//         }
+	//       },
+	//       "text": "language"
+	//     }
+	//   ],
+	//   "originalOutput": "README.md:3:10: \"langauge\" is a misspelling of \"language\"",
+	//   "format": "misspell"
+	// }
+	// {
+	//   "message": "\"Recieve\" is a misspelling of \"Receive\"",
+	//   "location": {
+	//     "path": "doc/guide.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 12,
+	//           "column": 1
+	//         },
+	//         "end": {
+	//           "line": 12,
+	//           "column": 8
+	//         }
+	//       },
+	//       "text": "Receive"
+	//     }
+	//   ],
+	//   "originalOutput": "doc/guide.md:12:1: \"Recieve\" is a misspelling of \"Receive\"",
+	//   "format": "misspell"
+	// }
+}
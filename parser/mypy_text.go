@@ -0,0 +1,72 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &MypyTextParser{}
+
+var (
+	// path:line[:col]: severity: message
+	mypyLineRe = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?: (error|warning|note): (.*)$`)
+	// message  [code]
+	mypyCodeRe = regexp.MustCompile(`^(.*?)  \[([\w-]+)\]$`)
+)
+
+// MypyTextParser is parser for mypy default text output.
+//
+//	path:line:col: severity: message  [code]
+//
+// Columns are reported with --show-column-numbers. Codes follow two spaces.
+// https://mypy.readthedocs.io/en/stable/error_codes.html
+type MypyTextParser struct{}
+
+// NewMypyTextParser returns a new MypyTextParser.
+func NewMypyTextParser() *MypyTextParser {
+	return &MypyTextParser{}
+}
+
+// Parse parses mypy text output. Notes are reported as INFO diagnostics.
+// Lines which don't look like diagnostics (e.g. the summary) are ignored.
+func (p *MypyTextParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := mypyLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[5],
+			Severity:       ParseSeverity(m[4]),
+			Format:         "mypy-text",
+			OriginalOutput: line,
+		}
+		if cm := mypyCodeRe.FindStringSubmatch(d.Message); cm != nil {
+			d.Message = cm[1]
+			d.Code = &rdf.Code{
+				Value: cm[2],
+				Url:   "https://mypy.readthedocs.io/en/stable/_refs.html#code-" + cm[2],
+			}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleMypyTextParser() {
+	// mypy --show-column-numbers .
+	const sample = `app/main.py:12:5: error: Incompatible types in assignment (expression has type "str", variable has type "int")  [assignment]
+app/main.py:20: error: Name "foo" is not defined  [name-defined]
+app/util.py:3:1: note: By default the bodies of untyped functions are not checked, consider using --check-untyped-defs  [annotation-unchecked]
+Found 2 errors in 1 file (checked 2 source files)`
+
+	p := NewMypyTextParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Incompatible types in assignment (expression has type \"str\", variable has type \"int\")",
+	//   "location": {
+	//     "path": "app/main.py",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "assignment",
+	//     "url": "https://mypy.readthedocs.io/en/stable/_refs.html#code-assignment"
+	//   },
+	//   "originalOutput": "app/main.py:12:5: error: Incompatible types in assignment (expression has type \"str\", variable has type \"int\")  [assignment]",
+	//   "format": "mypy-text"
+	// }
+	// {
+	//   "message": "Name \"foo\" is not defined",
+	//   "location": {
+	//     "path": "app/main.py",
+	//     "range": {
+	//       "start": {
+	//         "line": 20
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "name-defined",
+	//     "url": "https://mypy.readthedocs.io/en/stable/_refs.html#code-name-defined"
+	//   },
+	//   "originalOutput": "app/main.py:20: error: Name \"foo\" is not defined  [name-defined]",
+	//   "format": "mypy-text"
+	// }
+	// {
+	//   "message": "By default the bodies of untyped functions are not checked, consider using --check-untyped-defs",
+	//   "location": {
+	//     "path": "app/util.py",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "annotation-unchecked",
+	//     "url": "https://mypy.readthedocs.io/en/stable/_refs.html#code-annotation-unchecked"
+	//   },
+	//   "originalOutput": "app/util.py:3:1: note: By default the bodies of untyped functions are not checked, consider using --check-untyped-defs  [annotation-unchecked]",
+	//   "format": "mypy-text"
+	// }
+}
@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &NancyParser{}
+
+// nancyPath is the path nancy results are reported on.
+const nancyPath = "go.sum"
+
+// [1/6]pkg:golang/github.com/etcd-io/etcd@3.3.13
+var nancyPackageRe = regexp.MustCompile(`^\[\d+/\d+\]\s*pkg:golang/(\S+)`)
+
+// [CVE-2020-15114] title
+var nancyVulnRe = regexp.MustCompile(`^\[([^\]]+)\]\s*(.*)$`)
+
+// 7.7/10 (High)
+var nancyCVSSRe = regexp.MustCompile(`^(\d+(?:\.\d+)?)/10`)
+
+// nancyBorders are box-drawing characters of nancy tables.
+const nancyBorders = "┏┓┗┛┣┫┳┻╋┡┩┢┪┯┷┼├┤└┘┌┐┬┴━─┃│ \t"
+
+// NancyParser is parser for nancy text output
+// (go list -json -deps ./... | nancy sleuth).
+// https://github.com/sonatype-nexus-community/nancy
+type NancyParser struct{}
+
+// NewNancyParser returns a new NancyParser.
+func NewNancyParser() *NancyParser {
+	return &NancyParser{}
+}
+
+// nancyVuln is a vulnerability block being parsed.
+type nancyVuln struct {
+	pkg, id, title string
+	fields         map[string]string
+	lastKey        string
+	lines          []string
+}
+
+// Parse parses nancy text output. Each vulnerability block is reported as a
+// file-level diagnostic on go.sum with the vulnerability id (e.g. CVE) as
+// Code, the description as Message and the severity by the CVSS score (HIGH
+// and CRITICAL above 7.0 are ERROR, MEDIUM above 4.0 is WARNING, and INFO
+// otherwise). The summary table is ignored.
+func (p *NancyParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	var pkg string
+	var v *nancyVuln
+	flush := func() {
+		if v != nil {
+			ds = append(ds, v.diagnostic())
+			v = nil
+		}
+	}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		raw := s.Text()
+		line := strings.Trim(raw, nancyBorders)
+		if line == "" {
+			continue
+		}
+		if m := nancyPackageRe.FindStringSubmatch(line); m != nil {
+			flush()
+			pkg = m[1]
+			continue
+		}
+		if m := nancyVulnRe.FindStringSubmatch(line); m != nil && pkg != "" {
+			flush()
+			v = &nancyVuln{pkg: pkg, id: m[1], title: m[2], fields: make(map[string]string)}
+			v.lines = append(v.lines, raw)
+			continue
+		}
+		// │ key │ value │
+		cells := strings.Split(strings.TrimSpace(raw), "│")
+		if v == nil || len(cells) != 4 {
+			continue
+		}
+		v.lines = append(v.lines, raw)
+		key := strings.TrimSpace(cells[1])
+		value := strings.TrimSpace(cells[2])
+		if key == "" {
+			// Continuation of a wrapped value.
+			if v.lastKey != "" && value != "" {
+				v.fields[v.lastKey] += " " + value
+			}
+			continue
+		}
+		v.fields[key] = value
+		v.lastKey = key
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return ds, nil
+}
+
+func (v *nancyVuln) diagnostic() *rdf.Diagnostic {
+	desc := v.fields["Description"]
+	if desc == "" {
+		desc = v.title
+	}
+	d := &rdf.Diagnostic{
+		Location:       &rdf.Location{Path: nancyPath},
+		Message:        fmt.Sprintf("%s: %s", v.pkg, desc),
+		Severity:       nancySeverity(v.fields["CVSS Score"]),
+		Code:           &rdf.Code{Value: v.id, Url: v.fields["Link for more info"]},
+		Format:         "nancy",
+		OriginalOutput: strings.Join(v.lines, "\n"),
+	}
+	return d
+}
+
+// nancySeverity returns the severity of the CVSS score (e.g. "7.7/10 (High)").
+func nancySeverity(score string) rdf.Severity {
+	m := nancyCVSSRe.FindStringSubmatch(score)
+	if m == nil {
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+	cvss, _ := strconv.ParseFloat(m[1], 64)
+	switch {
+	case cvss >= 7.0:
+		return rdf.Severity_ERROR
+	case cvss >= 4.0:
+		return rdf.Severity_WARNING
+	default:
+		return rdf.Severity_INFO
+	}
+}
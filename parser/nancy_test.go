@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleNancyParser() {
+	// go list -json -deps ./... | nancy sleuth
+	const sample = `Checking for updates...
+Already up-to-date.
+pkg:golang/github.com/gorilla/websocket@1.4.2
+
+┏━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━┓
+┃ [1/1]pkg:golang/github.com/etcd-io/etcd@3.3.13 ┃
+┡━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━┩
+│ 1 known vulnerability affecting installed version │
+┢━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━┪
+┃ [CVE-2020-15114] Improper Neutralization of Input ┃
+┡━━━━━━━━━━━━━━━━━━━━┯━━━━━━━━━━━━━━━━━━━━━━━━━━━━━┩
+│ Description        │ In etcd before versions 3.3.23 and   │
+│                    │ 3.4.10, the etcd gateway can loop.   │
+├────────────────────┼──────────────────────────────────────┤
+│ OSS Index ID       │ 5def94e5-b89c-4a94-b9c6-ae0e120784c2 │
+├────────────────────┼──────────────────────────────────────┤
+│ CVSS Score         │ 7.7/10 (High)                        │
+├────────────────────┼──────────────────────────────────────┤
+│ Link for more info │ https://ossindex.sonatype.org/vulnerability/5def94e5 │
+└────────────────────┴──────────────────────────────────────┘
+
+┏━━━━━━━━━━━━━━━━━━━━━━━━━━┓
+┃ Summary                  ┃
+┣━━━━━━━━━━━━━━━━━━━━━━━━━┳┫
+┃ Audited Dependencies    ┃ 2 ┃
+┣━━━━━━━━━━━━━━━━━━━━━━━━━╋┫
+┃ Vulnerable Dependencies ┃ 1 ┃
+┗━━━━━━━━━━━━━━━━━━━━━━━━━┻┛
+`
+
+	p := NewNancyParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		d.OriginalOutput = "" // Omitted for brevity.
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "github.com/etcd-io/etcd@3.3.13: In etcd before versions 3.3.23 and 3.4.10, the etcd gateway can loop.",
+	//   "location": {
+	//     "path": "go.sum"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "CVE-2020-15114",
+	//     "url": "https://ossindex.sonatype.org/vulnerability/5def94e5"
+	//   },
+	//   "format": "nancy"
+	// }
+}
@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &NpmAuditParser{}
+
+// npmAuditPath is the path npm audit results are reported on.
+const npmAuditPath = "package-lock.json"
+
+// NpmAuditParser is parser for npm audit JSON output (npm audit --json).
+// https://docs.npmjs.com/cli/commands/npm-audit
+type NpmAuditParser struct{}
+
+// NewNpmAuditParser returns a new NpmAuditParser.
+func NewNpmAuditParser() *NpmAuditParser {
+	return &NpmAuditParser{}
+}
+
+// Parse parses npm audit JSON output. Each advisory of vulnerable packages is
+// reported as a file-level diagnostic on package-lock.json. Packages which
+// are only vulnerable via their dependencies are reported once.
+func (p *NpmAuditParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result NpmAuditResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode npm audit JSON: %w", err)
+	}
+	names := make([]string, 0, len(result.Vulnerabilities))
+	for name := range result.Vulnerabilities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var ds []*rdf.Diagnostic
+	for _, name := range names {
+		v := result.Vulnerabilities[name]
+		var deps []string
+		for _, via := range v.Via {
+			if via.Advisory == nil {
+				deps = append(deps, via.Dependency)
+				continue
+			}
+			a := via.Advisory
+			severity := a.Severity
+			if severity == "" {
+				severity = v.Severity
+			}
+			d := &rdf.Diagnostic{
+				Location:       &rdf.Location{Path: npmAuditPath},
+				Message:        fmt.Sprintf("%s: %s (%s)", name, a.Title, a.Range),
+				Severity:       ParseSeverity(severity),
+				Format:         "npm-audit",
+				OriginalOutput: fmt.Sprintf("%s %s: %s %s", severity, name, a.Title, a.URL),
+			}
+			if a.URL != "" {
+				d.Code = &rdf.Code{Value: path.Base(a.URL), Url: a.URL}
+			}
+			ds = append(ds, d)
+		}
+		if len(deps) > 0 && len(deps) == len(v.Via) {
+			msg := fmt.Sprintf("%s: vulnerable via %s (%s)", name, strings.Join(deps, ", "), v.Range)
+			ds = append(ds, &rdf.Diagnostic{
+				Location:       &rdf.Location{Path: npmAuditPath},
+				Message:        msg,
+				Severity:       ParseSeverity(v.Severity),
+				Format:         "npm-audit",
+				OriginalOutput: fmt.Sprintf("%s %s", v.Severity, msg),
+			})
+		}
+	}
+	return ds, nil
+}
+
+// NpmAuditResult represents npm audit JSON output (npm v7 or later).
+// {"auditReportVersion":2,"vulnerabilities":{"minimist":{"name":"minimist","severity":"critical","via":[{"source":1096466,"name":"minimist","title":"Prototype Pollution in minimist","url":"https://github.com/advisories/GHSA-xvch-5gv4-984h","severity":"critical","cwe":["CWE-1321"],"range":"<0.2.4"}],"range":"<0.2.4"}}}
+type NpmAuditResult struct {
+	Vulnerabilities map[string]*NpmAuditVulnerability `json:"vulnerabilities"`
+}
+
+// NpmAuditVulnerability represents a vulnerable package.
+type NpmAuditVulnerability struct {
+	Name     string         `json:"name"`
+	Severity string         `json:"severity"`
+	Via      []*NpmAuditVia `json:"via"`
+	Range    string         `json:"range"`
+}
+
+// NpmAuditVia represents a reason the package is vulnerable. It is either an
+// advisory for the package itself or the name of a vulnerable dependency.
+type NpmAuditVia struct {
+	Advisory   *NpmAuditAdvisory
+	Dependency string
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *NpmAuditVia) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &v.Dependency); err == nil {
+		return nil
+	}
+	v.Advisory = new(NpmAuditAdvisory)
+	return json.Unmarshal(data, v.Advisory)
+}
+
+// NpmAuditAdvisory represents a security advisory.
+type NpmAuditAdvisory struct {
+	Name     string   `json:"name"`
+	Title    string   `json:"title"`
+	URL      string   `json:"url"`
+	Severity string   `json:"severity"`
+	CWE      []string `json:"cwe"`
+	Range    string   `json:"range"`
+}
@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleNpmAuditParser() {
+	// npm audit --json
+	const sample = `{
+  "auditReportVersion": 2,
+  "vulnerabilities": {
+    "minimist": {
+      "name": "minimist",
+      "severity": "critical",
+      "isDirect": false,
+      "via": [
+        {
+          "source": 1096466,
+          "name": "minimist",
+          "dependency": "minimist",
+          "title": "Prototype Pollution in minimist",
+          "url": "https://github.com/advisories/GHSA-xvch-5gv4-984h",
+          "severity": "critical",
+          "cwe": ["CWE-1321"],
+          "range": "<0.2.4"
+        },
+        {
+          "source": 1097678,
+          "name": "minimist",
+          "dependency": "minimist",
+          "title": "Prototype Pollution in minimist",
+          "url": "https://github.com/advisories/GHSA-vh95-rmgr-6w4m",
+          "severity": "moderate",
+          "cwe": ["CWE-1321"],
+          "range": "<0.2.1"
+        }
+      ],
+      "effects": ["mkdirp"],
+      "range": "<=0.2.3",
+      "nodes": ["node_modules/minimist"],
+      "fixAvailable": true
+    },
+    "mkdirp": {
+      "name": "mkdirp",
+      "severity": "critical",
+      "isDirect": true,
+      "via": ["minimist"],
+      "effects": [],
+      "range": "0.4.1 - 0.5.1",
+      "nodes": ["node_modules/mkdirp"],
+      "fixAvailable": true
+    }
+  },
+  "metadata": {
+    "vulnerabilities": {"info": 0, "low": 0, "moderate": 0, "high": 0, "critical": 2, "total": 2}
+  }
+}`
+
+	p := NewNpmAuditParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "minimist: Prototype Pollution in minimist (<0.2.4)",
+	//   "location": {
+	//     "path": "package-lock.json"
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "GHSA-xvch-5gv4-984h",
+	//     "url": "https://github.com/advisories/GHSA-xvch-5gv4-984h"
+	//   },
+	//   "originalOutput": "critical minimist: Prototype Pollution in minimist https://github.com/advisories/GHSA-xvch-5gv4-984h",
+	//   "format": "npm-audit"
+	// }
+	// {
+	//   "message": "minimist: Prototype Pollution in minimist (<0.2.1)",
+	//   "location": {
+	//     "path": "package-lock.json"
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "GHSA-vh95-rmgr-6w4m",
+	//     "url": "https://github.com/advisories/GHSA-vh95-rmgr-6w4m"
+	//   },
+	//   "originalOutput": "moderate minimist: Prototype Pollution in minimist https://github.com/advisories/GHSA-vh95-rmgr-6w4m",
+	//   "format": "npm-audit"
+	// }
+	// {
+	//   "message": "mkdirp: vulnerable via minimist (0.4.1 - 0.5.1)",
+	//   "location": {
+	//     "path": "package-lock.json"
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "critical mkdirp: vulnerable via minimist (0.4.1 - 0.5.1)",
+	//   "format": "npm-audit"
+	// }
+}
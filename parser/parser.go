@@ -1,9 +1,12 @@
 package parser
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/reviewdog/errorformat/fmts"
 
@@ -16,16 +19,381 @@ type Parser interface {
 	Parse(r io.Reader) ([]*rdf.Diagnostic, error)
 }
 
+// StreamParser is Parser which can send diagnostics as soon as they are
+// parsed instead of returning them all at once.
+type StreamParser interface {
+	Parser
+	// ParseStream parses r and sends diagnostics to ch. It doesn't close ch.
+	ParseStream(r io.Reader, ch chan<- *rdf.Diagnostic) error
+}
+
 // Option represents option to create Parser. Either FormatName or
 // Errorformat should be specified.
 type Option struct {
 	FormatName  string
 	Errorformat []string
 	DiffStrip   int
+
+	// Path is the path of the checked file for formats which don't report it
+	// (e.g. markdown-link-check).
+	Path string
+	// FileReader reads files which diagnostics refer to. Optional.
+	FileReader FileReader
+
+	// UnescapeLiteralSequences replaces literal "\n", "\t" and "\r" sequences
+	// in messages with actual whitespace characters. It's useful for tools
+	// which escape messages twice.
+	UnescapeLiteralSequences bool
+
+	// CollapseWhitespace replaces runs of whitespace including newlines in
+	// messages with single spaces and trims them, e.g. to tidy multi-line
+	// messages with irregular indentation. It's applied after
+	// UnescapeLiteralSequences.
+	CollapseWhitespace bool
+
+	// ToSlash converts paths of diagnostics and related locations with
+	// filepath.ToSlash, e.g. to post results on Windows to services which use
+	// forward slashes. It's applied before other path rewrites.
+	ToSlash bool
+
+	// PathPrefixMap rewrites path prefixes of diagnostics. If multiple keys
+	// match a path, the longest one is used.
+	//   e.g. {"build/": "services/api/"} rewrites "build/main.go" to
+	//   "services/api/main.go".
+	PathPrefixMap map[string]string
+	// PathRegexp and PathReplace rewrite paths of diagnostics with
+	// regexp.ReplaceAllString after PathPrefixMap is applied.
+	PathRegexp  string
+	PathReplace string
+
+	// PathManifest maps emitted (e.g. generated) file paths to their source
+	// paths. Paths are looked up by exact match after PathPrefixMap and
+	// PathRegexp are applied. Unmapped paths are kept.
+	PathManifest map[string]string
+
+	// DefaultPath is set to diagnostics without path after path rewriting,
+	// e.g. run-level errors of config files, so that reporters which require
+	// paths can post them. Ranges are kept as is. It's applied before
+	// ChangedFiles, so the default path must be changed to keep them.
+	DefaultPath string
+
+	// ChangedFiles drops diagnostics whose paths aren't in the changed files,
+	// as a lightweight alternative to diff filtering. Paths of both are
+	// compared after cleaning and converting to forward slashes (e.g.
+	// "./a\b.go" matches "a/b.go" on Windows) and after path rewriting.
+	// Diagnostics without path are kept. Disabled if nil.
+	ChangedFiles []string
+
+	// RequireExistingPaths drops diagnostics which refer to files which don't
+	// exist, e.g. due to wrong path rewriting. It requires FileReader and is
+	// applied after path rewriting.
+	RequireExistingPaths bool
+
+	// FailOnMissingPaths makes Parse fail instead of dropping diagnostics when
+	// RequireExistingPaths is true.
+	FailOnMissingPaths bool
+
+	// LocateByPattern maps codes to regular expressions. File-level
+	// diagnostics with the code get the first line of the file matching the
+	// regular expression. It requires FileReader.
+	LocateByPattern map[string]string
+
+	// CRLFColumnFix fixes columns reported by tools which count "\r\n" as two
+	// characters. It requires FileReader.
+	CRLFColumnFix bool
+
+	// ColumnsAreRunes converts columns reported by tools which count runes
+	// (characters) to UTF-8 byte columns, which reviewdog expects. It defaults
+	// to true if nil, and columns are converted whenever FileReader is set, as
+	// the conversion needs source lines. Set it to false for tools which
+	// report byte columns. Setting it to true requires FileReader.
+	ColumnsAreRunes *bool
+
+	// FullLineRange synthesizes ranges of line-only diagnostics (no columns)
+	// to cover the whole lines, for tools which report only line numbers. It
+	// requires FileReader.
+	FullLineRange bool
+
+	// ForceSingleLineRange clamps ranges of diagnostics which span multiple
+	// lines to the start line for reporters which support only single-line
+	// annotations. The end is set to the end of the start line if FileReader
+	// is available, otherwise to the start. Suggestions are kept as is.
+	ForceSingleLineRange bool
+
+	// ExplodeMultiLineRange reports a diagnostic whose range spans multiple
+	// lines as one single-line diagnostic per covered line with the same
+	// message, for reporters which annotate single lines only. Suggestions
+	// are kept on the first line. Ranges over MaxExplodedLines lines are kept
+	// as is. It's applied before ForceSingleLineRange.
+	ExplodeMultiLineRange bool
+
+	// CollapseSeverity maps uncommon tool-specific severities (e.g. CRITICAL,
+	// BLOCKER, HINT) of checkstyle and junit with ParseSeverity to one of
+	// ERROR, WARNING and INFO, which reporters support. Otherwise such
+	// severities of these formats are treated as unknown. Other structured
+	// formats always map severities with ParseSeverity.
+	CollapseSeverity bool
+
+	// SeverityRemap maps severities of diagnostics to others, e.g. INFO to
+	// WARNING for gating. It's applied after all other severity handling
+	// (e.g. CollapseSeverity, DropSeverities and DedupByLocation), so that
+	// later MaxResults, FailOnSeverity and SeverityEmoji see remapped ones.
+	// Original severities are kept in Metadata with
+	// OriginalSeverityMetadataKey.
+	SeverityRemap map[rdf.Severity]rdf.Severity
+
+	// PreserveRaw stores the raw JSON element which produced each diagnostic
+	// in Diagnostic.Raw for debugging. It's only supported by cfn-lint,
+	// codeclimate, tslint-json, pyright, tfsec and kube-linter; other formats,
+	// including other JSON formats, leave Diagnostic.Raw empty.
+	PreserveRaw bool
+
+	// IncludeCodeFlows flattens locations of SARIF code flows (steps of thread
+	// flows) into related locations of results, up to MaxRelatedLocations.
+	// Code flows are ignored otherwise.
+	IncludeCodeFlows bool
+
+	// MaxRelatedLocations caps related locations of each diagnostic to bound
+	// memory used by deeply nested code flows. It defaults to
+	// DefaultMaxRelatedLocations.
+	MaxRelatedLocations int
+
+	// InlineRelatedLocations appends messages and locations of related
+	// locations to the primary message as a bulleted list and clears them,
+	// for reporters which don't render related locations.
+	InlineRelatedLocations bool
+
+	// MaxRelatedMessageBytes drops related locations of each diagnostic once
+	// the cumulative size of their messages exceeds it, which bounds inlined
+	// messages with InlineRelatedLocations as well. No limit if 0.
+	MaxRelatedMessageBytes int
+
+	// NumericSeverityThresholds maps numeric severities of parsers which read
+	// them (commitlint levels, codenarc and phpmd priorities, and SARIF ranks
+	// with SARIFSeverityFallback) instead of the default mapping of each tool.
+	NumericSeverityThresholds *NumericSeverityThresholds
+
+	// DropSeverities drops diagnostics of the severities (case-insensitive),
+	// e.g. "info". They are matched against mapped severities (ERROR, WARNING,
+	// INFO) of all formats, and against raw severities (e.g. "ignore") of
+	// checkstyle.
+	DropSeverities []string
+
+	// MinConfidence drops diagnostics whose confidence captured by parsers
+	// (e.g. gosec) is lower than it: "LOW", "MEDIUM" or "HIGH"
+	// (case-insensitive). Diagnostics without known confidence are kept.
+	// Disabled if empty.
+	MinConfidence string
+
+	// MaxResults caps the number of diagnostics, e.g. to fit a comment budget.
+	// Once it's exceeded, diagnostics are dropped in DropOrder of severities,
+	// so that the most important ones are kept. Diagnostics of the same
+	// severity are dropped from the end. No limit if 0.
+	MaxResults int
+
+	// DropOrder is the order of severities in which MaxResults drops
+	// diagnostics. Severities which aren't listed are dropped last. It
+	// defaults to DefaultDropOrder.
+	DropOrder []rdf.Severity
+
+	// IncludeToolExceptions reports <exception> elements of checkstyle files,
+	// which the tool emits when it failed to analyze the file, as file-level
+	// errors. They are ignored otherwise.
+	IncludeToolExceptions bool
+
+	// RequireCode drops diagnostics without rule code.
+	RequireCode bool
+
+	// MessageRewrites replaces messages which exactly match keys with the
+	// values, e.g. to normalize verbose tool messages to team-standard
+	// wording. Messages are matched after UnescapeLiteralSequences and
+	// CollapseWhitespace.
+	MessageRewrites map[string]string
+
+	// IgnoreMessagePatterns drops diagnostics whose messages match any of the
+	// regular expressions, e.g. known-benign messages. Messages are matched
+	// after MessageRewrites.
+	IgnoreMessagePatterns []string
+
+	// CodeTrimSuffix is trimmed from code values (e.g. "/recommended" of
+	// "no-unused-vars/recommended") so that base codes can be matched. Code
+	// URLs are kept.
+	CodeTrimSuffix string
+
+	// DecodeInput decodes input with DecodeWrappers before parsing, e.g. gzip
+	// or zstd compressed reports of CI artifacts.
+	DecodeInput bool
+	// Base64Input decodes input from base64 before detecting compression. It
+	// implies DecodeInput.
+	Base64Input bool
+
+	// StripANSI removes ANSI escape sequences (e.g. colors of golangci-lint
+	// --out-format=colored-tab) from input before parsing.
+	StripANSI bool
+
+	// StopLine ends input before the first line which equals it (e.g.
+	// "===REVIEWDOG-END===" of multiplexed output), so that following lines
+	// are ignored by line-based parsers. Disabled if empty.
+	StopLine string
+
+	// MaxParseDuration bounds the wall-clock time to read input. Once it's
+	// exceeded, input is treated as ended and Parse returns diagnostics
+	// gathered so far along with ErrMaxParseDurationExceeded. Parsing input
+	// which is already read isn't interrupted. A Read of the input blocked at
+	// the deadline is left running in a goroutine until it returns, so callers
+	// should close the input (e.g. a pipe) to release it. No limit if 0.
+	MaxParseDuration time.Duration
+
+	// FailOnSeverity makes Parse return ErrThresholdExceeded along with all
+	// diagnostics if any diagnostic is as or more severe than it (e.g. ERROR
+	// only, or ERROR and WARNING for WARNING), which is handy for CI gating.
+	// Callers should check the error with errors.Is. ErrMaxParseDurationExceeded
+	// takes precedence. Disabled by default.
+	FailOnSeverity rdf.Severity
+
+	// SARIFAllLocations reports a diagnostic for each location of a SARIF
+	// result. Only the first location is used by default.
+	SARIFAllLocations bool
+
+	// SARIFSeverityFallback derives severities of SARIF results without level
+	// (of themselves or rule defaults) from rank, mapped with
+	// DefaultSARIFRankThresholds, then from properties.severity. Such results
+	// are WARNING by default as SARIF specifies.
+	SARIFSeverityFallback bool
+
+	// SARIFStrict makes Parse return descriptive errors for SARIF which lacks
+	// required structure (version, runs, and tool.driver with name of each
+	// run) instead of yielding no diagnostics silently. Lenient by default.
+	SARIFStrict bool
+
+	// HonorSuppressions drops SARIF results which are suppressed (e.g. with
+	// inline comments or baselines).
+	HonorSuppressions bool
+
+	// BlameFunc returns the last author of the line (e.g. with `git blame`),
+	// which is stored in BlameAuthor of each diagnostic. Errors are ignored and
+	// leave BlameAuthor empty. Optional.
+	BlameFunc func(path string, line int) (string, error)
+
+	// BlameEmailFunc returns the email of the last author of the line, which
+	// is stored in BlameEmail of each diagnostic. It's independent of
+	// BlameFunc. Errors are ignored and leave BlameEmail empty. Optional.
+	BlameEmailFunc func(path string, line int) (string, error)
+
+	// DiffPositions maps paths to file lines to their positions within the
+	// diff (e.g. positions of GitHub review comments), which are stored in
+	// DiffPosition of each diagnostic by the start line. Diagnostics without
+	// diff positions are flagged with NoDiffPositionMetadataKey in Metadata.
+	DiffPositions map[string]map[int]int
+
+	// MergeContiguousSuggestions merges line-wise suggestions of a diagnostic
+	// whose ranges are adjacent (end line + 1 == next start line) into one
+	// multi-line suggestion.
+	MergeContiguousSuggestions bool
+
+	// SortSuggestions sorts suggestions of each diagnostic by start line and
+	// column so that they can be applied sequentially.
+	SortSuggestions bool
+
+	// SeparateSuggestions reports each suggestion as a separate diagnostic
+	// located at the suggestion range, for reporters which don't handle
+	// suggestions attached to diagnostics. The original diagnostic is kept
+	// without suggestions.
+	SeparateSuggestions bool
+
+	// DedupSuggestionsGlobally removes suggestions with the same path, range
+	// and text as earlier ones, even of other diagnostics, so that applying
+	// all suggestions doesn't conflict. The first one is kept.
+	DedupSuggestionsGlobally bool
+
+	// DedupByLocation collapses diagnostics with the same path and range
+	// regardless of messages, e.g. reported by multiple tools. The first one
+	// is kept with the highest severity and distinct messages joined.
+	// Diagnostics without range are kept as is.
+	DedupByLocation bool
+
+	// CountDuplicates collapses diagnostics with the same path and message
+	// (e.g. file-level "trailing whitespace" reported per line) into the first
+	// one, and appends the number of occurrences to its message, e.g.
+	// "trailing whitespace (42 occurrences)". The count is stored in
+	// Diagnostic.OccurrenceCount and appended after fingerprinting.
+	CountDuplicates bool
+
+	// SeenFingerprints is a set of fingerprints (see DiagnosticFingerprint)
+	// already reported. Diagnostics with seen fingerprints are dropped and
+	// fingerprints of new ones are added to the set and stored in
+	// Diagnostic.Fingerprint before messages are decorated, so that
+	// WriteFingerprintIndex writes the same fingerprints. It is owned by the
+	// caller and can be shared across Parse calls, but not concurrently.
+	SeenFingerprints map[string]bool
+
+	// GroupAndSort groups diagnostics by path in the order of first
+	// occurrences of paths, and sorts diagnostics of each path by line and
+	// column stably, unlike a global sort by path.
+	GroupAndSort bool
+
+	// SortByFileMtime orders diagnostics so that ones in recently modified
+	// files come first, then by path and line. Diagnostics in files whose
+	// modification times are unknown come last. It requires FileReader which
+	// implements FileStater.
+	SortByFileMtime bool
+
+	// Reverse reverses the final order of diagnostics after other options
+	// (e.g. SortByFileMtime) are applied, e.g. for reporters which post
+	// comments bottom-up.
+	Reverse bool
+
+	// SeverityEmoji prefixes messages with an emoji of the severity (❌ for
+	// ERROR, ⚠️ for WARNING and ℹ️ for INFO) for chat and review ergonomics.
+	// Fingerprints are computed without the prefix.
+	SeverityEmoji bool
+
+	// MessageSuffix is appended to every message after a newline, e.g. "See
+	// CONTRIBUTING.md for how to fix." Fingerprints are computed without it.
+	MessageSuffix string
+
+	// Stats is filled with ParseStats of each Parse call if it's not nil. It
+	// is owned by the caller and must not be shared by concurrent Parse calls.
+	Stats *ParseStats
+
+	// EmitParseMeta appends an INFO diagnostic without location when the
+	// parser finds no diagnostics (e.g. "errorformat matched 0 of 500 lines"),
+	// so that parse problems surface in reviews. golangci-lint JSON also
+	// reports enabled linters in an INFO diagnostic without location.
+	EmitParseMeta bool
+
+	// ExtraMetadata is added to Metadata of each diagnostic, e.g. to tag it
+	// with the producing tool or run id. Metadata reported by the tool takes
+	// precedence.
+	ExtraMetadata map[string]string
+
+	// CorrelationID is stored in Metadata of each diagnostic with
+	// CorrelationIDMetadataKey for tracing across a pipeline. If it's empty
+	// and GenerateCorrelationID is true, a random id is generated for each
+	// Parse call.
+	CorrelationID         string
+	GenerateCorrelationID bool
+
+	// IndexResults stores the 0-based index of each diagnostic in the parser
+	// output in Index before other post-processing (e.g. sorting and
+	// filtering), so that callers can refer to diagnostics stably.
+	IndexResults bool
+
+	// Category is stored in Category of each diagnostic, e.g. "security" or
+	// "style", to group results of multiple linters in dashboards.
+	Category string
 }
 
 // New returns Parser based on Option.
 func New(opt *Option) (Parser, error) {
+	p, err := newParser(opt)
+	if err != nil {
+		return nil, err
+	}
+	return newProcessor(p, opt)
+}
+
+func newParser(opt *Option) (Parser, error) {
 	name := opt.FormatName
 
 	if name != "" && len(opt.Errorformat) > 0 {
@@ -34,13 +402,145 @@ func New(opt *Option) (Parser, error) {
 
 	switch name {
 	case "checkstyle":
-		return NewCheckStyleParser(), nil
+		return &CheckStyleParser{
+			mapSeverity:           severityMapper(opt),
+			dropSeverities:        lowerSet(opt.DropSeverities),
+			includeToolExceptions: opt.IncludeToolExceptions,
+		}, nil
+	case "golangci-lint-checkstyle":
+		p := newGolangCICheckStyleParser()
+		p.dropSeverities = lowerSet(opt.DropSeverities)
+		p.includeToolExceptions = opt.IncludeToolExceptions
+		return p, nil
 	case "rdjsonl":
 		return NewRDJSONLParser(), nil
 	case "rdjson":
 		return NewRDJSONParser(), nil
 	case "diff":
 		return NewDiffParser(opt.DiffStrip), nil
+	case "markdown-link-check":
+		return NewMarkdownLinkCheckParser(opt.Path, opt.FileReader), nil
+	case "stylelint-compact":
+		return NewStylelintCompactParser(), nil
+	case "rdjson-framed":
+		return NewFramedRDJSONParser(), nil
+	case "junit":
+		return &JUnitParser{mapSeverity: severityMapper(opt)}, nil
+	case "cspell":
+		return NewCSpellParser(), nil
+	case "actionlint":
+		return NewActionlintTextParser(), nil
+	case "codeclimate":
+		return &CodeClimateParser{preserveRaw: opt.PreserveRaw}, nil
+	case "sqlfluff":
+		return NewSqlfluffParser(), nil
+	case "sarif":
+		return &SarifParser{
+			allLocations:        opt.SARIFAllLocations,
+			honorSuppressions:   opt.HonorSuppressions,
+			includeCodeFlows:    opt.IncludeCodeFlows,
+			maxRelatedLocations: maxRelatedLocations(opt),
+			fr:                  opt.FileReader,
+			severityFallback:    opt.SARIFSeverityFallback,
+			rankThresholds:      opt.NumericSeverityThresholds,
+			strict:              opt.SARIFStrict,
+		}, nil
+	case "golangci-lint-plain":
+		return NewGolangCIPlainParser(), nil
+	case "vint":
+		return NewVintParser(), nil
+	case "commitlint":
+		return &CommitlintParser{mapLevel: numericSeverityMapper(opt)}, nil
+	case "bracket-tag":
+		return NewBracketTagParser(), nil
+	case "gofmt-list":
+		return NewGofmtListParser(), nil
+	case "cfn-lint":
+		return &CfnLintParser{preserveRaw: opt.PreserveRaw}, nil
+	case "pyright":
+		return &PyrightParser{preserveRaw: opt.PreserveRaw}, nil
+	case "misspell":
+		return NewMisspellParser(), nil
+	case "buildifier":
+		return NewBuildifierParser(), nil
+	case "editorconfig-checker":
+		return NewEditorconfigCheckerParser(), nil
+	case "validation-list":
+		return NewValidationListParser(ValidationListOption{}), nil
+	case "tslint-json":
+		return &TSLintParser{preserveRaw: opt.PreserveRaw}, nil
+	case "codenarc":
+		return &CodeNarcParser{mapPriority: numericSeverityMapper(opt)}, nil
+	case "teamcity":
+		return NewTeamCityParser(), nil
+	case "bundler-audit":
+		path := opt.Path
+		if path == "" {
+			path = "Gemfile.lock"
+		}
+		p := NewAdvisoryBlockParser(AdvisoryBlockOption{Path: path})
+		p.format = name
+		return p, nil
+	case "proselint":
+		return NewProselintParser(opt.Path), nil
+	case "kube-linter":
+		return &KubeLinterParser{preserveRaw: opt.PreserveRaw}, nil
+	case "alex":
+		return NewAlexParser(), nil
+	case "tfsec":
+		return &TfsecParser{preserveRaw: opt.PreserveRaw}, nil
+	case "golangci-lint-line-number":
+		return NewGolangCILineNumberParser(), nil
+	case "go-pos-json":
+		return NewGoPosJSONParser(), nil
+	case "github-actions":
+		return NewGitHubActionsParser(), nil
+	case "kics":
+		return NewKicsParser(), nil
+	case "status-table":
+		return NewStatusTableParser(StatusTableOption{}), nil
+	case "golangci-lint-json":
+		return &GolangCIJSONParser{fr: opt.FileReader, emitLinters: opt.EmitParseMeta}, nil
+	case "gocyclo":
+		return NewGocycloParser(), nil
+	case "npm-audit":
+		return NewNpmAuditParser(), nil
+	case "sbt-log":
+		return NewSbtParser(), nil
+	case "errcheck":
+		return NewErrcheckParser(), nil
+	case "deno-lint":
+		return NewDenoLintParser(), nil
+	case "gcc":
+		return NewGCCParser(), nil
+	case "dash-separated":
+		return NewDashSeparatedParser(DefaultDashSeparator), nil
+	case "golangci-lint-tab":
+		return NewGolangCITabParser(), nil
+	case "woke":
+		return NewWokeParser(), nil
+	case "mypy-text":
+		return NewMypyTextParser(), nil
+	case "phpmd":
+		return &PHPMDParser{mapPriority: numericSeverityMapper(opt)}, nil
+	case "gosec-json":
+		return NewGosecParser(), nil
+	case "ktlint":
+		return NewKtlintParser(), nil
+	case "hlint-json":
+		return NewHlintParser(), nil
+	case "jscpd":
+		return NewJSCPDParser(), nil
+	case "typos":
+		return NewTyposParser(), nil
+	case "nancy":
+		return NewNancyParser(), nil
+	case "cargo-audit":
+		return NewCargoAuditParser(), nil
+	case "golint-nocol":
+		return NewGolintParser(), nil
+	case "tsc-text":
+		return NewTSCParser(), nil
 	}
 
 	// use defined errorformat
@@ -54,7 +554,14 @@ func New(opt *Option) (Parser, error) {
 	if len(opt.Errorformat) == 0 {
 		return nil, errors.New("errorformat is empty")
 	}
-	return NewErrorformatParserString(opt.Errorformat)
+	p, err := NewErrorformatParserString(opt.Errorformat)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		p.format = name
+	}
+	return p, nil
 }
 
 func severity(s string) rdf.Severity {
@@ -70,3 +577,134 @@ func severity(s string) rdf.Severity {
 		return rdf.Severity_UNKNOWN_SEVERITY
 	}
 }
+
+// ParseSeverity parses a severity string reported by tools. It's
+// case-insensitive and maps common synonyms (e.g. "err", "warn", "note",
+// "hint", "critical", "blocker", "major" and "minor") and numeric strings of
+// rdf.Severity values ("1" to "3", and "4" for LSP hints) to one of ERROR,
+// WARNING and INFO. It returns UNKNOWN_SEVERITY for unknown severities.
+//
+// Security scanners (gosec, tfsec, kics, npm audit, ...) rank findings as
+// critical/high/medium/low, so "low" maps to INFO rather than WARNING to keep
+// one mapping across all parsers.
+func ParseSeverity(s string) rdf.Severity {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "error", "err", "e", "1",
+		"critical", "blocker", "fatal", "high", "major", "severe":
+		return rdf.Severity_ERROR
+	case "warning", "warn", "w", "2",
+		"medium", "moderate", "minor":
+		return rdf.Severity_WARNING
+	case "info", "i", "note", "n", "notice", "3", "4", "low", "weak warning",
+		"hint", "suggestion", "information", "informational", "style", "trivial", "ignore":
+		return rdf.Severity_INFO
+	default:
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+}
+
+// lowerSet returns set of lower-cased ss, or nil if ss is empty.
+func lowerSet(ss []string) map[string]bool {
+	if len(ss) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(ss))
+	for _, s := range ss {
+		set[strings.ToLower(s)] = true
+	}
+	return set
+}
+
+// unmarshalRaw decodes JSON b into v and keeps a copy of b in raw. It's shared
+// by UnmarshalJSON of the elements of formats supporting Option.PreserveRaw.
+func unmarshalRaw(b []byte, v interface{}, raw *json.RawMessage) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	*raw = append(json.RawMessage(nil), b...)
+	return nil
+}
+
+// NoDiffPositionMetadataKey is the metadata key set to "true" for
+// diagnostics without diff positions when Option.DiffPositions is set.
+const NoDiffPositionMetadataKey = "no_diff_position"
+
+// CorrelationIDMetadataKey is the metadata key of Option.CorrelationID.
+const CorrelationIDMetadataKey = "correlation_id"
+
+// OriginalSeverityMetadataKey is the metadata key of the original severity of
+// diagnostics whose severities are changed by Option.SeverityRemap.
+const OriginalSeverityMetadataKey = "original_severity"
+
+// MaxExplodedLines is the maximum number of lines of ranges which
+// Option.ExplodeMultiLineRange explodes.
+const MaxExplodedLines = 100
+
+// DefaultSARIFRankThresholds maps SARIF ranks with
+// Option.SARIFSeverityFallback unless Option.NumericSeverityThresholds is set.
+var DefaultSARIFRankThresholds = NumericSeverityThresholds{Error: 70, Warning: 40}
+
+// DefaultDropOrder is the default of Option.DropOrder, which drops
+// diagnostics in ascending order of severity.
+var DefaultDropOrder = []rdf.Severity{
+	rdf.Severity_UNKNOWN_SEVERITY,
+	rdf.Severity_INFO,
+	rdf.Severity_WARNING,
+	rdf.Severity_ERROR,
+}
+
+// DefaultMaxRelatedLocations is the default of Option.MaxRelatedLocations.
+const DefaultMaxRelatedLocations = 100
+
+func maxRelatedLocations(opt *Option) int {
+	if opt.MaxRelatedLocations > 0 {
+		return opt.MaxRelatedLocations
+	}
+	return DefaultMaxRelatedLocations
+}
+
+// NumericSeverityThresholds maps numeric severities on a tool-specific scale
+// (e.g. 0-3 or 1-10) to rdf.Severity. Severities at or beyond Error are ERROR,
+// ones at or beyond Warning are WARNING and others are INFO. Set Error less
+// than Warning for scales where smaller values are more severe (e.g.
+// priority 1 is the highest).
+type NumericSeverityThresholds struct {
+	Error   float64
+	Warning float64
+}
+
+func (t *NumericSeverityThresholds) severity(v float64) rdf.Severity {
+	beyond := func(threshold float64) bool {
+		if t.Error < t.Warning {
+			return v <= threshold
+		}
+		return v >= threshold
+	}
+	switch {
+	case beyond(t.Error):
+		return rdf.Severity_ERROR
+	case beyond(t.Warning):
+		return rdf.Severity_WARNING
+	default:
+		return rdf.Severity_INFO
+	}
+}
+
+// numericSeverityMapper returns a function which maps numeric severities with
+// opt.NumericSeverityThresholds, or nil to use the default mapping of parsers.
+func numericSeverityMapper(opt *Option) func(int) rdf.Severity {
+	t := opt.NumericSeverityThresholds
+	if t == nil {
+		return nil
+	}
+	return func(v int) rdf.Severity { return t.severity(float64(v)) }
+}
+
+// severityMapper returns a function which maps tool-specific severities
+// based on opt.
+func severityMapper(opt *Option) func(string) rdf.Severity {
+	if opt.CollapseSeverity {
+		return ParseSeverity
+	}
+	return severity
+}
@@ -2,7 +2,10 @@ package parser
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
 )
 
 func TestNewParser(t *testing.T) {
@@ -23,12 +26,30 @@ func TestNewParser(t *testing.T) {
 			},
 			typ: &RDJSONLParser{},
 		},
+		{
+			in: &Option{
+				FormatName: "markdown-link-check",
+			},
+			typ: &MarkdownLinkCheckParser{},
+		},
 		{
 			in: &Option{
 				FormatName: "golint",
 			},
 			typ: &ErrorformatParser{},
 		},
+		{
+			in: &Option{
+				FormatName: "golint-nocol",
+			},
+			typ: &GolintParser{},
+		},
+		{
+			in: &Option{
+				FormatName: "tsc-text",
+			},
+			typ: &TSCParser{},
+		},
 		{
 			in: &Option{
 				Errorformat: []string{`%f:%l:%c:%m`},
@@ -67,3 +88,227 @@ func TestNewParser(t *testing.T) {
 		}
 	}
 }
+
+func TestCollapseSeverity(t *testing.T) {
+	const sample = `<?xml version="1.0" encoding="utf-8"?><checkstyle version="4.3"><file name="a.go">
+<error line="1" severity="CRITICAL" message="critical" />
+<error line="2" severity="blocker" message="blocker" />
+<error line="3" severity="Major" message="major" />
+<error line="4" severity="minor" message="minor" />
+<error line="5" severity="HINT" message="hint" />
+<error line="6" severity="suggestion" message="suggestion" />
+<error line="7" severity="warning" message="warning" />
+<error line="8" severity="whatever" message="unknown" />
+</file></checkstyle>`
+	want := []rdf.Severity{
+		rdf.Severity_ERROR,
+		rdf.Severity_ERROR,
+		rdf.Severity_ERROR,
+		rdf.Severity_WARNING,
+		rdf.Severity_INFO,
+		rdf.Severity_INFO,
+		rdf.Severity_WARNING,
+		rdf.Severity_UNKNOWN_SEVERITY,
+	}
+	for _, collapse := range []bool{false, true} {
+		p, err := New(&Option{FormatName: "checkstyle", CollapseSeverity: collapse})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, d := range ds {
+			w := want[i]
+			if !collapse && i != 6 {
+				w = rdf.Severity_UNKNOWN_SEVERITY
+			}
+			if got := d.GetSeverity(); got != w {
+				t.Errorf("CollapseSeverity=%v: %s: got %v, want %v", collapse, d.GetMessage(), got, w)
+			}
+		}
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		in   string
+		want rdf.Severity
+	}{
+		{"error", rdf.Severity_ERROR},
+		{"ERROR", rdf.Severity_ERROR},
+		{"err", rdf.Severity_ERROR},
+		{" Err ", rdf.Severity_ERROR},
+		{"critical", rdf.Severity_ERROR},
+		{"Blocker", rdf.Severity_ERROR},
+		{"major", rdf.Severity_ERROR},
+		{"1", rdf.Severity_ERROR},
+		{"warning", rdf.Severity_WARNING},
+		{"Warn", rdf.Severity_WARNING},
+		{"minor", rdf.Severity_WARNING},
+		{"2", rdf.Severity_WARNING},
+		{"info", rdf.Severity_INFO},
+		{"note", rdf.Severity_INFO},
+		{"NOTE", rdf.Severity_INFO},
+		{"hint", rdf.Severity_INFO},
+		{"3", rdf.Severity_INFO},
+		{"4", rdf.Severity_INFO},
+		{"LOW", rdf.Severity_INFO},
+		{"WEAK WARNING", rdf.Severity_INFO},
+		{"Ignore", rdf.Severity_INFO},
+		{"", rdf.Severity_UNKNOWN_SEVERITY},
+		{"0", rdf.Severity_UNKNOWN_SEVERITY},
+		{"whatever", rdf.Severity_UNKNOWN_SEVERITY},
+	}
+	for _, tt := range tests {
+		if got := ParseSeverity(tt.in); got != tt.want {
+			t.Errorf("ParseSeverity(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNew_format(t *testing.T) {
+	tests := []struct {
+		opt   *Option
+		input string
+		want  string
+	}{
+		{
+			opt:   &Option{FormatName: "rdjsonl"},
+			input: `{"message":"msg"}`,
+			want:  "rdjsonl",
+		},
+		{
+			opt:   &Option{FormatName: "rdjsonl"},
+			input: `{"message":"msg","format":"eslint"}`,
+			want:  "eslint",
+		},
+		{
+			opt: &Option{FormatName: "checkstyle"},
+			input: `<?xml version="1.0" encoding="utf-8"?><checkstyle version="4.3">` +
+				`<file name="a.js"><error line="1" column="1" severity="error" message="msg" source="rule" /></file></checkstyle>`,
+			want: "checkstyle",
+		},
+		{
+			opt:   &Option{FormatName: "golint"},
+			input: "a.go:1:1: msg",
+			want:  "golint",
+		},
+		{
+			opt:   &Option{Errorformat: []string{"%f:%l: %m"}},
+			input: "a.go:1: msg",
+			want:  "errorformat",
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != 1 {
+			t.Fatalf("%v: got %d diagnostics, want 1", tt.opt, len(ds))
+		}
+		if got := ds[0].GetFormat(); got != tt.want {
+			t.Errorf("%v: got format %q, want %q", tt.opt, got, tt.want)
+		}
+	}
+}
+
+func TestNumericSeverityThresholds(t *testing.T) {
+	// commitlint-compatible output of a tool which reports levels on a 1-10
+	// scale.
+	const sample = `{"results":[{"errors":[
+{"level":10,"name":"r10","message":"level 10"},
+{"level":8,"name":"r8","message":"level 8"},
+{"level":7,"name":"r7","message":"level 7"},
+{"level":4,"name":"r4","message":"level 4"},
+{"level":1,"name":"r1","message":"level 1"}
+]}]}`
+	want := []rdf.Severity{
+		rdf.Severity_ERROR,
+		rdf.Severity_ERROR,
+		rdf.Severity_WARNING,
+		rdf.Severity_WARNING,
+		rdf.Severity_INFO,
+	}
+	p, err := New(&Option{
+		FormatName:                "commitlint",
+		NumericSeverityThresholds: &NumericSeverityThresholds{Error: 8, Warning: 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, d := range ds {
+		if got := d.GetSeverity(); got != want[i] {
+			t.Errorf("%s: got %v, want %v", d.GetMessage(), got, want[i])
+		}
+	}
+
+	// Smaller priorities are more severe in CodeNarc.
+	const codenarc = `<?xml version="1.0"?><CodeNarc><Package path="src"><File name="a.groovy">
+<Violation ruleName="R1" priority="1" lineNumber="1"><Message>p1</Message></Violation>
+<Violation ruleName="R2" priority="2" lineNumber="2"><Message>p2</Message></Violation>
+<Violation ruleName="R3" priority="3" lineNumber="3"><Message>p3</Message></Violation>
+</File></Package></CodeNarc>`
+	p, err = New(&Option{
+		FormatName:                "codenarc",
+		NumericSeverityThresholds: &NumericSeverityThresholds{Error: 2, Warning: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err = p.Parse(strings.NewReader(codenarc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []rdf.Severity{rdf.Severity_ERROR, rdf.Severity_ERROR, rdf.Severity_WARNING}
+	for i, d := range ds {
+		if got := d.GetSeverity(); got != want[i] {
+			t.Errorf("%s: got %v, want %v", d.GetMessage(), got, want[i])
+		}
+	}
+}
+
+func TestPreserveRaw(t *testing.T) {
+	elems := []string{
+		`{"rule_id":"AVD-AWS-0086","description":"No public access block","severity":"HIGH","location":{"filename":"main.tf","start_line":1,"end_line":4}}`,
+		`{
+    "rule_id": "AVD-AWS-0132",
+    "description": "Bucket does not encrypt data",
+    "severity": "LOW",
+    "location": {"filename": "main.tf", "start_line": 6, "end_line": 6}
+  }`,
+	}
+	input := `{"results":[` + strings.Join(elems, ", ") + `]}`
+	for _, preserve := range []bool{false, true} {
+		p, err := New(&Option{FormatName: "tfsec", PreserveRaw: preserve})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != len(elems) {
+			t.Fatalf("got %d diagnostics, want %d", len(ds), len(elems))
+		}
+		for i, d := range ds {
+			want := elems[i]
+			if !preserve {
+				want = ""
+			}
+			if got := string(d.GetRaw()); got != want {
+				t.Errorf("PreserveRaw=%v: raw of %q = %q, want %q", preserve, d.GetMessage(), got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package parser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &PHPMDParser{}
+
+// PHPMDParser is parser for PHPMD XML report (phpmd src xml rulesets).
+// https://phpmd.org/documentation/index.html
+type PHPMDParser struct {
+	mapPriority func(int) rdf.Severity
+}
+
+// NewPHPMDParser returns a new PHPMDParser.
+func NewPHPMDParser() *PHPMDParser {
+	return &PHPMDParser{}
+}
+
+// Parse parses PHPMD XML report. Violations are reported as line-wise ranges
+// from begin lines to end lines.
+func (p *PHPMDParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var report PHPMDReport
+	if err := xml.NewDecoder(r).Decode(&report); err != nil {
+		return nil, fmt.Errorf("failed to decode PHPMD XML: %w", err)
+	}
+	mapPriority := phpmdSeverity
+	if p.mapPriority != nil {
+		mapPriority = p.mapPriority
+	}
+	var ds []*rdf.Diagnostic
+	for _, file := range report.Files {
+		for _, v := range file.Violations {
+			msg := strings.TrimSpace(v.Message)
+			d := &rdf.Diagnostic{
+				Location: &rdf.Location{Path: file.Name},
+				Message:  msg,
+				Severity: mapPriority(v.Priority),
+				Format:   "phpmd",
+				OriginalOutput: fmt.Sprintf("%s:%d: [%s/%s] P%d: %s",
+					file.Name, v.BeginLine, v.RuleSet, v.Rule, v.Priority, msg),
+			}
+			if v.BeginLine > 0 {
+				d.Location.Range = &rdf.Range{Start: &rdf.Position{Line: int32(v.BeginLine)}}
+				if v.EndLine > v.BeginLine {
+					d.Location.Range.End = &rdf.Position{Line: int32(v.EndLine)}
+				}
+			}
+			if v.Rule != "" {
+				d.Code = &rdf.Code{Value: v.Rule, Url: v.ExternalInfoURL}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+func phpmdSeverity(priority int) rdf.Severity {
+	switch priority {
+	case 1, 2:
+		return rdf.Severity_ERROR
+	case 3:
+		return rdf.Severity_WARNING
+	case 4, 5:
+		return rdf.Severity_INFO
+	default:
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+}
+
+// PHPMDReport represents PHPMD XML report.
+// <pmd version="2.15.0"><file name="/app/src/Foo.php"><violation beginline="10" endline="42" rule="ExcessiveMethodLength" ruleset="Code Size Rules" externalInfoUrl="https://phpmd.org/rules/codesize.html#excessivemethodlength" priority="3">msg</violation></file></pmd>
+type PHPMDReport struct {
+	XMLName xml.Name     `xml:"pmd"`
+	Files   []*PHPMDFile `xml:"file"`
+}
+
+// PHPMDFile represents violations in a file.
+type PHPMDFile struct {
+	Name       string            `xml:"name,attr"`
+	Violations []*PHPMDViolation `xml:"violation"`
+}
+
+// PHPMDViolation represents a rule violation. Priority is 1 (highest) to 5
+// (lowest).
+type PHPMDViolation struct {
+	BeginLine       int    `xml:"beginline,attr"`
+	EndLine         int    `xml:"endline,attr"`
+	Rule            string `xml:"rule,attr"`
+	RuleSet         string `xml:"ruleset,attr"`
+	ExternalInfoURL string `xml:"externalInfoUrl,attr"`
+	Priority        int    `xml:"priority,attr"`
+	Message         string `xml:",chardata"`
+}
@@ -0,0 +1,77 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExamplePHPMDParser() {
+	// phpmd src xml cleancode,codesize,unusedcode
+	const sample = `<?xml version="1.0" encoding="UTF-8" ?>
+<pmd version="2.15.0" timestamp="2023-12-01T10:00:00+00:00">
+  <file name="src/Service/Order.php">
+    <violation beginline="12" endline="58" rule="ExcessiveMethodLength" ruleset="Code Size Rules" package="App\Service" externalInfoUrl="https://phpmd.org/rules/codesize.html#excessivemethodlength" class="Order" method="process" priority="3">
+      The method process() has 47 lines of code. Current threshold is set to 40. Avoid really long methods.
+    </violation>
+    <violation beginline="20" endline="20" rule="UnusedLocalVariable" ruleset="Unused Code Rules" externalInfoUrl="https://phpmd.org/rules/unusedcode.html#unusedlocalvariable" priority="1">
+      Avoid unused local variables such as '$tmp'.
+    </violation>
+  </file>
+</pmd>`
+
+	p := NewPHPMDParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "The method process() has 47 lines of code. Current threshold is set to 40. Avoid really long methods.",
+	//   "location": {
+	//     "path": "src/Service/Order.php",
+	//     "range": {
+	//       "start": {
+	//         "line": 12
+	//       },
+	//       "end": {
+	//         "line": 58
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "ExcessiveMethodLength",
+	//     "url": "https://phpmd.org/rules/codesize.html#excessivemethodlength"
+	//   },
+	//   "originalOutput": "src/Service/Order.php:12: [Code Size Rules/ExcessiveMethodLength] P3: The method process() has 47 lines of code. Current threshold is set to 40. Avoid really long methods.",
+	//   "format": "phpmd"
+	// }
+	// {
+	//   "message": "Avoid unused local variables such as '$tmp'.",
+	//   "location": {
+	//     "path": "src/Service/Order.php",
+	//     "range": {
+	//       "start": {
+	//         "line": 20
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "UnusedLocalVariable",
+	//     "url": "https://phpmd.org/rules/unusedcode.html#unusedlocalvariable"
+	//   },
+	//   "originalOutput": "src/Service/Order.php:20: [Unused Code Rules/UnusedLocalVariable] P1: Avoid unused local variables such as '$tmp'.",
+	//   "format": "phpmd"
+	// }
+}
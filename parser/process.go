@@ -0,0 +1,1333 @@
+package parser
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+// processStep transforms diagnostics parsed by Parser. Returning an error
+// fails the Parse call.
+type processStep func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error)
+
+// ErrMaxParseDurationExceeded is returned along with diagnostics parsed so far
+// when reading input takes longer than Option.MaxParseDuration. Callers should
+// check it with errors.Is and may treat it as non-fatal.
+var ErrMaxParseDurationExceeded = errors.New("max parse duration exceeded")
+
+// ErrThresholdExceeded is returned along with all diagnostics when any
+// diagnostic meets or exceeds Option.FailOnSeverity. Callers should check it
+// with errors.Is.
+var ErrThresholdExceeded = errors.New("diagnostic severity threshold exceeded")
+
+// processor is Parser which post-processes diagnostics returned by the
+// underlying Parser based on Option.
+type processor struct {
+	p              Parser
+	steps          []processStep
+	maxDuration    time.Duration
+	stripANSI      bool
+	stopLine       string
+	failOnSeverity rdf.Severity
+	stats          *ParseStats
+	emitMeta       bool
+	// decodeOpt is passed to DecodeWrappers if input is decoded.
+	decodeOpt *Option
+	// format is the format name of meta diagnostics.
+	format string
+}
+
+// newProcessor returns Parser which applies post-processing steps enabled in
+// opt to results of p. It returns p as is if there are no steps to apply.
+func newProcessor(p Parser, opt *Option) (Parser, error) {
+	var steps []processStep
+	if opt.IndexResults {
+		steps = append(steps, indexResults)
+	}
+	// Rewrite paths first so that following steps see the final paths.
+	if opt.ToSlash {
+		steps = append(steps, eachDiagnostic(toSlash))
+	}
+	if len(opt.PathPrefixMap) > 0 {
+		steps = append(steps, eachDiagnostic(rewritePathPrefix(opt.PathPrefixMap)))
+	}
+	if opt.PathRegexp != "" {
+		re, err := regexp.Compile(opt.PathRegexp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PathRegexp: %w", err)
+		}
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			if loc := d.GetLocation(); loc != nil {
+				loc.Path = re.ReplaceAllString(loc.Path, opt.PathReplace)
+			}
+		}))
+	}
+	if len(opt.PathManifest) > 0 {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			if loc := d.GetLocation(); loc != nil {
+				if src, ok := opt.PathManifest[loc.Path]; ok {
+					loc.Path = src
+				}
+			}
+		}))
+	}
+	if opt.DefaultPath != "" {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			if d.GetLocation().GetPath() != "" {
+				return
+			}
+			if d.Location == nil {
+				d.Location = &rdf.Location{}
+			}
+			d.Location.Path = opt.DefaultPath
+		}))
+	}
+	if opt.ChangedFiles != nil {
+		changed := make(map[string]bool, len(opt.ChangedFiles))
+		for _, path := range opt.ChangedFiles {
+			changed[cleanPath(path)] = true
+		}
+		steps = append(steps, filterDiagnostics(func(d *rdf.Diagnostic) bool {
+			path := d.GetLocation().GetPath()
+			return path == "" || changed[cleanPath(path)]
+		}))
+	}
+	if opt.RequireExistingPaths {
+		if opt.FileReader == nil {
+			return nil, errors.New("RequireExistingPaths requires FileReader")
+		}
+		steps = append(steps, requireExistingPaths(opt.FileReader, opt.FailOnMissingPaths))
+	}
+	if len(opt.LocateByPattern) > 0 {
+		if opt.FileReader == nil {
+			return nil, errors.New("LocateByPattern requires FileReader")
+		}
+		patterns := make(map[string]*regexp.Regexp, len(opt.LocateByPattern))
+		for code, pattern := range opt.LocateByPattern {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid LocateByPattern for %q: %w", code, err)
+			}
+			patterns[code] = re
+		}
+		steps = append(steps, withLineCache(opt.FileReader, locateByPattern(patterns)))
+	}
+	// Convert rune columns before CRLFColumnFix, which works on bytes.
+	if opt.ColumnsAreRunes != nil && *opt.ColumnsAreRunes && opt.FileReader == nil {
+		return nil, errors.New("ColumnsAreRunes requires FileReader")
+	}
+	if (opt.ColumnsAreRunes == nil || *opt.ColumnsAreRunes) && opt.FileReader != nil {
+		steps = append(steps, withLineCache(opt.FileReader, runeColumnsToBytes))
+	}
+	if opt.CRLFColumnFix {
+		if opt.FileReader == nil {
+			return nil, errors.New("CRLFColumnFix requires FileReader")
+		}
+		steps = append(steps, withLineCache(opt.FileReader, fixCRLFColumns))
+	}
+	// Synthesize full-line ranges after column conversions as they are
+	// already byte columns.
+	if opt.FullLineRange {
+		if opt.FileReader == nil {
+			return nil, errors.New("FullLineRange requires FileReader")
+		}
+		steps = append(steps, withLineCache(opt.FileReader, fullLineRange))
+	}
+	// Sort before merging so that out of order contiguous suggestions are
+	// merged as well.
+	if opt.SortSuggestions {
+		steps = append(steps, eachDiagnostic(sortSuggestions))
+	}
+	if opt.MergeContiguousSuggestions {
+		steps = append(steps, eachDiagnostic(mergeContiguousSuggestions))
+	}
+	if opt.DedupSuggestionsGlobally {
+		steps = append(steps, dedupSuggestions)
+	}
+	if opt.SeparateSuggestions {
+		steps = append(steps, separateSuggestions)
+	}
+	if opt.UnescapeLiteralSequences {
+		steps = append(steps, eachDiagnostic(unescapeLiteralSequences))
+	}
+	if opt.CollapseWhitespace {
+		steps = append(steps, eachDiagnostic(collapseWhitespace))
+	}
+	if len(opt.MessageRewrites) > 0 {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			if msg, ok := opt.MessageRewrites[d.GetMessage()]; ok {
+				d.Message = msg
+			}
+		}))
+	}
+	if opt.ExplodeMultiLineRange {
+		steps = append(steps, explodeMultiLineRange)
+	}
+	if opt.ForceSingleLineRange {
+		steps = append(steps, withLineCache(opt.FileReader, forceSingleLineRange))
+	}
+	if opt.BlameFunc != nil {
+		steps = append(steps, eachDiagnostic(blameLine(opt.BlameFunc, func(d *rdf.Diagnostic, author string) {
+			d.BlameAuthor = author
+		})))
+	}
+	if opt.BlameEmailFunc != nil {
+		steps = append(steps, eachDiagnostic(blameLine(opt.BlameEmailFunc, func(d *rdf.Diagnostic, email string) {
+			d.BlameEmail = email
+		})))
+	}
+	if opt.DiffPositions != nil {
+		steps = append(steps, eachDiagnostic(diffPosition(opt.DiffPositions)))
+	}
+	if opt.CodeTrimSuffix != "" {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			if code := d.GetCode(); code != nil {
+				code.Value = strings.TrimSuffix(code.Value, opt.CodeTrimSuffix)
+			}
+		}))
+	}
+	if len(opt.ExtraMetadata) > 0 {
+		steps = append(steps, eachDiagnostic(addMetadata(opt.ExtraMetadata)))
+	}
+	if opt.CorrelationID != "" || opt.GenerateCorrelationID {
+		steps = append(steps, addCorrelationID(opt.CorrelationID))
+	}
+	if opt.Category != "" {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			d.Category = opt.Category
+		}))
+	}
+	if drop := lowerSet(opt.DropSeverities); drop != nil {
+		steps = append(steps, filterDiagnostics(func(d *rdf.Diagnostic) bool {
+			return !drop[strings.ToLower(d.GetSeverity().String())]
+		}))
+	}
+	if opt.MinConfidence != "" {
+		min := confidenceRank(opt.MinConfidence)
+		if min == 0 {
+			return nil, fmt.Errorf("invalid MinConfidence: %q", opt.MinConfidence)
+		}
+		steps = append(steps, filterDiagnostics(func(d *rdf.Diagnostic) bool {
+			rank := confidenceRank(d.GetConfidence())
+			return rank == 0 || rank >= min
+		}))
+	}
+	if len(opt.IgnoreMessagePatterns) > 0 {
+		patterns := make([]*regexp.Regexp, len(opt.IgnoreMessagePatterns))
+		for i, pattern := range opt.IgnoreMessagePatterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IgnoreMessagePatterns %q: %w", pattern, err)
+			}
+			patterns[i] = re
+		}
+		steps = append(steps, filterDiagnostics(func(d *rdf.Diagnostic) bool {
+			for _, re := range patterns {
+				if re.MatchString(d.GetMessage()) {
+					return false
+				}
+			}
+			return true
+		}))
+	}
+	if opt.RequireCode {
+		steps = append(steps, filterDiagnostics(hasCode))
+	}
+	if opt.DedupByLocation {
+		steps = append(steps, dedupByLocation)
+	}
+	if opt.CountDuplicates {
+		steps = append(steps, collapseDuplicates)
+	}
+	// Remap severities after all other severity handling.
+	if len(opt.SeverityRemap) > 0 {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			to, ok := opt.SeverityRemap[d.GetSeverity()]
+			if !ok || to == d.GetSeverity() {
+				return
+			}
+			if d.Metadata == nil {
+				d.Metadata = make(map[string]string)
+			}
+			d.Metadata[OriginalSeverityMetadataKey] = d.GetSeverity().String()
+			d.Severity = to
+		}))
+	}
+	if opt.GroupAndSort {
+		steps = append(steps, groupAndSort)
+	}
+	if opt.SortByFileMtime {
+		fs, ok := opt.FileReader.(FileStater)
+		if !ok {
+			return nil, errors.New("SortByFileMtime requires FileReader which implements FileStater")
+		}
+		steps = append(steps, sortByFileMtime(fs))
+	}
+	// Deduplicate last as fingerprints depend on the final diagnostics.
+	if opt.SeenFingerprints != nil {
+		steps = append(steps, filterDiagnostics(unseen(opt.SeenFingerprints)))
+	}
+	if opt.MaxResults > 0 {
+		order := opt.DropOrder
+		if len(order) == 0 {
+			order = DefaultDropOrder
+		}
+		steps = append(steps, capResults(opt.MaxResults, order))
+	}
+	// Decorate messages after fingerprinting so that fingerprints don't
+	// depend on the option.
+	if opt.MaxRelatedMessageBytes > 0 {
+		steps = append(steps, eachDiagnostic(capRelatedMessages(opt.MaxRelatedMessageBytes)))
+	}
+	if opt.InlineRelatedLocations {
+		steps = append(steps, eachDiagnostic(inlineRelatedLocations))
+	}
+	// SARIF results may report occurrence counts as well.
+	if opt.CountDuplicates || opt.FormatName == "sarif" {
+		steps = append(steps, eachDiagnostic(appendOccurrenceCount))
+	}
+	if opt.SeverityEmoji {
+		steps = append(steps, eachDiagnostic(prefixSeverityEmoji))
+	}
+	if opt.MessageSuffix != "" {
+		steps = append(steps, eachDiagnostic(func(d *rdf.Diagnostic) {
+			d.Message += "\n" + opt.MessageSuffix
+		}))
+	}
+	// Reverse the final order, e.g. after SortByFileMtime.
+	if opt.Reverse {
+		steps = append(steps, reverseDiagnostics)
+	}
+	decode := opt.DecodeInput || opt.Base64Input
+	if len(steps) == 0 && opt.MaxParseDuration <= 0 && !opt.StripANSI && opt.StopLine == "" && !decode &&
+		opt.FailOnSeverity == rdf.Severity_UNKNOWN_SEVERITY && opt.Stats == nil && !opt.EmitParseMeta {
+		return p, nil
+	}
+	format := opt.FormatName
+	if format == "" {
+		format = "errorformat"
+	}
+	proc := &processor{
+		p:              p,
+		steps:          steps,
+		maxDuration:    opt.MaxParseDuration,
+		stripANSI:      opt.StripANSI,
+		stopLine:       opt.StopLine,
+		failOnSeverity: opt.FailOnSeverity,
+		stats:          opt.Stats,
+		emitMeta:       opt.EmitParseMeta,
+		format:         format,
+	}
+	if decode {
+		proc.decodeOpt = opt
+	}
+	return proc, nil
+}
+
+func (p *processor) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var dr *deadlineReader
+	if p.maxDuration > 0 {
+		dr = newDeadlineReader(r, p.maxDuration)
+		defer dr.stop()
+		r = dr
+	}
+	if p.decodeOpt != nil {
+		rc, err := DecodeWrappers(r, p.decodeOpt)
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		r = rc
+	}
+	if p.stripANSI {
+		r = &ansiStripReader{r: r}
+	}
+	if p.stopLine != "" {
+		r = &stopLineReader{r: bufio.NewReader(r), line: p.stopLine}
+	}
+	var lr *lineCountReader
+	if p.stats != nil || p.emitMeta {
+		// Wrap the deadline reader so that lines are counted only in this
+		// goroutine.
+		lr = &lineCountReader{r: r}
+		r = lr
+	}
+	ds, err := p.p.Parse(r)
+	if dr != nil && dr.exceeded {
+		// Errors are likely caused by truncated input. Return what we have.
+		err = ErrMaxParseDurationExceeded
+	} else if err != nil {
+		return nil, err
+	}
+	total := len(ds)
+	var consumed int
+	if p.stats != nil {
+		consumed = consumedLines(ds)
+	}
+	// Count drops per step as some steps (e.g. ExplodeMultiLineRange) add
+	// diagnostics.
+	var skipped int
+	for _, step := range p.steps {
+		n := len(ds)
+		var serr error
+		if ds, serr = step(ds); serr != nil {
+			return nil, serr
+		}
+		if len(ds) < n {
+			skipped += n - len(ds)
+		}
+	}
+	if p.stats != nil {
+		*p.stats = ParseStats{
+			Lines:      lr.count(),
+			Total:      total,
+			Valid:      len(ds),
+			Skipped:    skipped,
+			BySeverity: make(map[rdf.Severity]int),
+		}
+		for _, d := range ds {
+			p.stats.BySeverity[d.GetSeverity()]++
+		}
+		p.stats.ParseWarnings = p.parseWarnings(ds, lr.count(), consumed, skipped)
+	}
+	if err == nil && p.failOnSeverity != rdf.Severity_UNKNOWN_SEVERITY {
+		for _, d := range ds {
+			if meetsSeverity(d.GetSeverity(), p.failOnSeverity) {
+				err = ErrThresholdExceeded
+				break
+			}
+		}
+	}
+	if p.emitMeta && total == 0 {
+		ds = append(ds, p.metaDiagnostic(lr.count()))
+	}
+	return ds, err
+}
+
+// parseWarnings returns non-fatal parse problems. Skipped lines are reported
+// only for errorformat, whose diagnostics keep all lines they were parsed
+// from.
+func (p *processor) parseWarnings(ds []*rdf.Diagnostic, lines, consumed, skipped int) []string {
+	var warnings []string
+	if _, ok := p.p.(*ErrorformatParser); ok && lines > consumed {
+		warnings = append(warnings, fmt.Sprintf("%d of %d lines skipped", lines-consumed, lines))
+	}
+	var noLocation int
+	for _, d := range ds {
+		if d.GetLocation().GetPath() == "" {
+			noLocation++
+		}
+	}
+	if noLocation > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d diagnostics had no location", noLocation))
+	}
+	if skipped > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d diagnostics skipped by post-processing", skipped))
+	}
+	return warnings
+}
+
+// capResults returns processStep which drops diagnostics beyond max in the
+// order of severities, keeping the order of the rest.
+func capResults(max int, order []rdf.Severity) processStep {
+	rank := make(map[rdf.Severity]int, len(order))
+	for i, s := range order {
+		rank[s] = i + 1
+	}
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		if len(ds) <= max {
+			return ds, nil
+		}
+		// Keep diagnostics of the least dropped severities first.
+		idx := make([]int, len(ds))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(i, j int) bool {
+			return dropRank(rank, ds[idx[i]]) > dropRank(rank, ds[idx[j]])
+		})
+		keep := make(map[int]bool, max)
+		for _, i := range idx[:max] {
+			keep[i] = true
+		}
+		filtered := ds[:0]
+		for i, d := range ds {
+			if keep[i] {
+				filtered = append(filtered, d)
+			}
+		}
+		return filtered, nil
+	}
+}
+
+// dropRank returns the rank of d in the drop order. Severities which aren't
+// in the order are ranked last.
+func dropRank(rank map[rdf.Severity]int, d *rdf.Diagnostic) int {
+	if r, ok := rank[d.GetSeverity()]; ok {
+		return r
+	}
+	return len(rank) + 1
+}
+
+// consumedLines returns the number of input lines diagnostics were parsed
+// from.
+func consumedLines(ds []*rdf.Diagnostic) int {
+	var n int
+	for _, d := range ds {
+		if d.GetOriginalOutput() != "" {
+			n += strings.Count(d.GetOriginalOutput(), "\n") + 1
+		}
+	}
+	return n
+}
+
+// metaDiagnostic returns a diagnostic which explains that the parser found no
+// diagnostics in the input.
+func (p *processor) metaDiagnostic(lines int) *rdf.Diagnostic {
+	msg := fmt.Sprintf("%s input contained no diagnostics", p.format)
+	if _, ok := p.p.(*ErrorformatParser); ok {
+		msg = fmt.Sprintf("errorformat matched 0 of %d lines", lines)
+	}
+	return &rdf.Diagnostic{
+		Message:  msg,
+		Severity: rdf.Severity_INFO,
+		Source:   &rdf.Source{Name: "reviewdog"},
+		Format:   p.format,
+	}
+}
+
+// meetsSeverity reports whether s is as or more severe than threshold.
+// Unknown severity never meets it.
+func meetsSeverity(s, threshold rdf.Severity) bool {
+	return s != rdf.Severity_UNKNOWN_SEVERITY && s <= threshold
+}
+
+// deadlineReader is io.Reader which reports EOF once the deadline is exceeded,
+// even if the underlying Read blocks. Underlying Reads are done by a single
+// goroutine, which is started by the first Read and exits by stop.
+type deadlineReader struct {
+	r        io.Reader
+	timer    *time.Timer
+	reqs     chan int
+	results  chan readResult
+	err      error
+	exceeded bool
+}
+
+type readResult struct {
+	b   []byte
+	err error
+}
+
+func newDeadlineReader(r io.Reader, d time.Duration) *deadlineReader {
+	return &deadlineReader{r: r, timer: time.NewTimer(d)}
+}
+
+func (r *deadlineReader) Read(p []byte) (int, error) {
+	if r.exceeded {
+		return 0, io.EOF
+	}
+	if r.err != nil {
+		return 0, r.err
+	}
+	if r.reqs == nil {
+		r.reqs = make(chan int, 1)
+		r.results = make(chan readResult, 1)
+		go r.readLoop()
+	}
+	r.reqs <- len(p)
+	select {
+	case res := <-r.results:
+		r.err = res.err
+		return copy(p, res.b), res.err
+	case <-r.timer.C:
+		r.exceeded = true
+		return 0, io.EOF
+	}
+}
+
+// readLoop reads the underlying reader on each request. The buffer is reused
+// as Read copies the result before the next request.
+func (r *deadlineReader) readLoop() {
+	var buf []byte
+	for size := range r.reqs {
+		if cap(buf) < size {
+			buf = make([]byte, size)
+		}
+		n, err := r.r.Read(buf[:size])
+		r.results <- readResult{b: buf[:n], err: err}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (r *deadlineReader) stop() {
+	r.timer.Stop()
+	if r.reqs != nil {
+		close(r.reqs)
+	}
+}
+
+// ansiStripReader is io.Reader which removes ANSI escape sequences. CSI
+// sequences (e.g. "\x1b[1;31m") are removed entirely, and other escapes
+// drop the byte following ESC. Sequences can span Read calls.
+type ansiStripReader struct {
+	r     io.Reader
+	state int
+}
+
+const (
+	ansiText = iota
+	ansiEscape
+	ansiCSI
+)
+
+func (r *ansiStripReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.r.Read(p)
+		w := 0
+		for _, c := range p[:n] {
+			switch r.state {
+			case ansiText:
+				if c == 0x1b {
+					r.state = ansiEscape
+					continue
+				}
+				p[w] = c
+				w++
+			case ansiEscape:
+				if c == '[' {
+					r.state = ansiCSI
+				} else {
+					r.state = ansiText
+				}
+			case ansiCSI:
+				// Parameter and intermediate bytes continue the sequence
+				// until a final byte.
+				if c >= 0x40 && c <= 0x7e {
+					r.state = ansiText
+				}
+			}
+		}
+		if w > 0 || n == 0 || err != nil {
+			return w, err
+		}
+	}
+}
+
+// stopLineReader is io.Reader which reports EOF before the first line which
+// equals line, ignoring line endings.
+type stopLineReader struct {
+	r    *bufio.Reader
+	line string
+	buf  []byte
+	err  error
+}
+
+func (r *stopLineReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		l, err := r.r.ReadString('\n')
+		if l != "" && strings.TrimRight(l, "\r\n") == r.line {
+			r.err = io.EOF
+			return 0, io.EOF
+		}
+		r.buf, r.err = []byte(l), err
+		if len(r.buf) == 0 {
+			return 0, r.err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// eachDiagnostic returns processStep which applies f to each diagnostic.
+func eachDiagnostic(f func(d *rdf.Diagnostic)) processStep {
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		for _, d := range ds {
+			f(d)
+		}
+		return ds, nil
+	}
+}
+
+// filterDiagnostics returns processStep which keeps only diagnostics which
+// satisfy keep.
+func filterDiagnostics(keep func(d *rdf.Diagnostic) bool) processStep {
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		filtered := ds[:0]
+		for _, d := range ds {
+			if keep(d) {
+				filtered = append(filtered, d)
+			}
+		}
+		return filtered, nil
+	}
+}
+
+// withLineCache returns processStep which applies f to each diagnostic with
+// lineCache shared in a single Parse call.
+func withLineCache(fr FileReader, f func(c *lineCache, d *rdf.Diagnostic)) processStep {
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		c := newLineCache(fr)
+		for _, d := range ds {
+			f(c, d)
+		}
+		return ds, nil
+	}
+}
+
+// requireExistingPaths returns processStep which drops diagnostics whose
+// paths don't exist, or fails if failOnMissing is true. Diagnostics without
+// path are kept.
+func requireExistingPaths(fr FileReader, failOnMissing bool) processStep {
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		exists := make(map[string]bool)
+		filtered := ds[:0]
+		for _, d := range ds {
+			path := d.GetLocation().GetPath()
+			if path == "" {
+				filtered = append(filtered, d)
+				continue
+			}
+			ok, cached := exists[path]
+			if !cached {
+				_, err := fr.ReadFile(path)
+				ok = !errors.Is(err, os.ErrNotExist)
+				exists[path] = ok
+			}
+			if ok {
+				filtered = append(filtered, d)
+			} else if failOnMissing {
+				return nil, fmt.Errorf("diagnostic refers to nonexistent path: %s", path)
+			}
+		}
+		return filtered, nil
+	}
+}
+
+var literalSequenceReplacer = strings.NewReplacer(`\n`, "\n", `\t`, "\t", `\r`, "\r")
+
+func unescapeLiteralSequences(d *rdf.Diagnostic) {
+	d.Message = literalSequenceReplacer.Replace(d.Message)
+}
+
+// capRelatedMessages returns a function which drops related locations once
+// the cumulative size of their messages exceeds max bytes.
+func capRelatedMessages(max int) func(d *rdf.Diagnostic) {
+	return func(d *rdf.Diagnostic) {
+		var size int
+		for i, rl := range d.GetRelatedLocations() {
+			size += len(rl.GetMessage())
+			if size > max {
+				d.RelatedLocations = d.RelatedLocations[:i]
+				return
+			}
+		}
+	}
+}
+
+func inlineRelatedLocations(d *rdf.Diagnostic) {
+	if len(d.GetRelatedLocations()) == 0 {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(d.GetMessage())
+	for _, rl := range d.GetRelatedLocations() {
+		loc := rl.GetLocation().GetPath()
+		if line := rl.GetLocation().GetRange().GetStart().GetLine(); line > 0 {
+			loc = fmt.Sprintf("%s:%d", loc, line)
+		}
+		if rl.GetMessage() != "" {
+			fmt.Fprintf(&b, "\n- %s (%s)", rl.GetMessage(), loc)
+		} else {
+			fmt.Fprintf(&b, "\n- %s", loc)
+		}
+	}
+	d.Message = b.String()
+	d.RelatedLocations = nil
+}
+
+func collapseWhitespace(d *rdf.Diagnostic) {
+	d.Message = strings.Join(strings.Fields(d.Message), " ")
+}
+
+func indexResults(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	for i, d := range ds {
+		d.Index = int32(i)
+	}
+	return ds, nil
+}
+
+// cleanPath normalizes the path for comparison.
+func cleanPath(path string) string {
+	return filepath.ToSlash(filepath.Clean(path))
+}
+
+func toSlash(d *rdf.Diagnostic) {
+	if loc := d.GetLocation(); loc != nil {
+		loc.Path = filepath.ToSlash(loc.Path)
+	}
+	for _, rl := range d.GetRelatedLocations() {
+		if loc := rl.GetLocation(); loc != nil {
+			loc.Path = filepath.ToSlash(loc.Path)
+		}
+	}
+}
+
+func rewritePathPrefix(m map[string]string) func(d *rdf.Diagnostic) {
+	prefixes := make([]string, 0, len(m))
+	for prefix := range m {
+		prefixes = append(prefixes, prefix)
+	}
+	// Longest prefix first.
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) > len(prefixes[j]) })
+	return func(d *rdf.Diagnostic) {
+		loc := d.GetLocation()
+		if loc == nil {
+			return
+		}
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(loc.Path, prefix) {
+				loc.Path = m[prefix] + strings.TrimPrefix(loc.Path, prefix)
+				return
+			}
+		}
+	}
+}
+
+// eachColumnPosition applies f to each position of d and its suggestions
+// which has column.
+func eachColumnPosition(d *rdf.Diagnostic, f func(pos *rdf.Position)) {
+	apply := func(rng *rdf.Range) {
+		for _, pos := range []*rdf.Position{rng.GetStart(), rng.GetEnd()} {
+			if pos != nil && pos.Column != 0 {
+				f(pos)
+			}
+		}
+	}
+	apply(d.GetLocation().GetRange())
+	for _, s := range d.GetSuggestions() {
+		apply(s.GetRange())
+	}
+}
+
+// fixCRLFColumns fixes columns reported by tools which count "\r\n" as two
+// characters. Columns which land on or past "\r" at the end of line are
+// decremented.
+func fixCRLFColumns(c *lineCache, d *rdf.Diagnostic) {
+	path := d.GetLocation().GetPath()
+	eachColumnPosition(d, func(pos *rdf.Position) {
+		line, ok := c.line(path, int(pos.Line))
+		if !ok || !strings.HasSuffix(line, "\r") {
+			return
+		}
+		if int(pos.Column) >= len(line) { // Column of "\r" is len(line).
+			pos.Column--
+		}
+	})
+}
+
+// runeColumnsToBytes converts columns counted in runes to UTF-8 byte columns.
+// Columns past the end of line are shifted by the same amount as the end of
+// line.
+func runeColumnsToBytes(c *lineCache, d *rdf.Diagnostic) {
+	path := d.GetLocation().GetPath()
+	eachColumnPosition(d, func(pos *rdf.Position) {
+		line, ok := c.line(path, int(pos.Line))
+		if !ok {
+			return
+		}
+		n := int(pos.Column) - 1 // Runes before the position.
+		for i := range line {
+			if n == 0 {
+				pos.Column = int32(i + 1)
+				return
+			}
+			n--
+		}
+		pos.Column = int32(len(line) + n + 1)
+	})
+}
+
+// fullLineRange sets range of line-only diagnostic to cover whole lines. The
+// range is kept as is if the lines are unknown.
+func fullLineRange(c *lineCache, d *rdf.Diagnostic) {
+	rng := d.GetLocation().GetRange()
+	if !isLinewise(rng) {
+		return
+	}
+	path := d.GetLocation().GetPath()
+	if _, ok := c.line(path, int(rng.GetStart().GetLine())); !ok {
+		return
+	}
+	end := endLine(rng)
+	line, ok := c.line(path, int(end))
+	if !ok {
+		return
+	}
+	rng.Start.Column = 1
+	rng.End = &rdf.Position{Line: end, Column: int32(len(strings.TrimSuffix(line, "\r")) + 1)}
+}
+
+func forceSingleLineRange(c *lineCache, d *rdf.Diagnostic) {
+	rng := d.GetLocation().GetRange()
+	if rng.GetEnd() == nil || rng.GetEnd().GetLine() == rng.GetStart().GetLine() {
+		return
+	}
+	start := rng.GetStart()
+	if start.GetColumn() == 0 { // Line-wise.
+		rng.End = &rdf.Position{Line: start.GetLine()}
+		return
+	}
+	if line, ok := c.line(d.GetLocation().GetPath(), int(start.GetLine())); ok {
+		rng.End = &rdf.Position{Line: start.GetLine(), Column: int32(len(strings.TrimSuffix(line, "\r")) + 1)}
+		return
+	}
+	rng.End = nil
+}
+
+// locateByPattern returns a function which sets the line of file-level
+// diagnostics to the first line matching the pattern for the code.
+func locateByPattern(patterns map[string]*regexp.Regexp) func(c *lineCache, d *rdf.Diagnostic) {
+	return func(c *lineCache, d *rdf.Diagnostic) {
+		loc := d.GetLocation()
+		if loc.GetPath() == "" || loc.GetRange().GetStart().GetLine() != 0 {
+			return
+		}
+		re, ok := patterns[d.GetCode().GetValue()]
+		if !ok {
+			return
+		}
+		lines, _ := c.lines(loc.GetPath())
+		for i, line := range lines {
+			if re.MatchString(line) {
+				loc.Range = &rdf.Range{Start: &rdf.Position{Line: int32(i + 1)}}
+				return
+			}
+		}
+	}
+}
+
+// dedupByLocation collapses diagnostics with the same path and range into
+// the first one, which gets the highest severity and distinct messages
+// joined with newlines. Diagnostics without range (e.g. file-level ones) are
+// passed through as they don't share a location.
+func dedupByLocation(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	type key struct {
+		path                     string
+		sline, scol, eline, ecol int32
+	}
+	firsts := make(map[key]*rdf.Diagnostic)
+	messages := make(map[key][]string)
+	deduped := ds[:0]
+	for _, d := range ds {
+		rng := d.GetLocation().GetRange()
+		if rng.GetStart().GetLine() == 0 {
+			deduped = append(deduped, d)
+			continue
+		}
+		k := key{
+			path:  d.GetLocation().GetPath(),
+			sline: rng.GetStart().GetLine(), scol: rng.GetStart().GetColumn(),
+			eline: rng.GetEnd().GetLine(), ecol: rng.GetEnd().GetColumn(),
+		}
+		first, ok := firsts[k]
+		if !ok {
+			firsts[k] = d
+			messages[k] = []string{d.GetMessage()}
+			deduped = append(deduped, d)
+			continue
+		}
+		if severityRank(d.GetSeverity()) < severityRank(first.GetSeverity()) {
+			first.Severity = d.GetSeverity()
+		}
+		if !containsString(messages[k], d.GetMessage()) {
+			messages[k] = append(messages[k], d.GetMessage())
+			first.Message = strings.Join(messages[k], "\n")
+		}
+	}
+	return deduped, nil
+}
+
+// collapseDuplicates keeps the first one of diagnostics with the same path
+// and message, and stores the total number of occurrences in its
+// OccurrenceCount. Each diagnostic counts as its own OccurrenceCount, or 1 if
+// it's unknown.
+func collapseDuplicates(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	type key struct{ path, message string }
+	firsts := make(map[key]*rdf.Diagnostic)
+	counts := make(map[*rdf.Diagnostic]int32)
+	collapsed := ds[:0]
+	for _, d := range ds {
+		k := key{path: d.GetLocation().GetPath(), message: d.GetMessage()}
+		first, ok := firsts[k]
+		if !ok {
+			firsts[k] = d
+			first = d
+			collapsed = append(collapsed, d)
+		}
+		n := d.GetOccurrenceCount()
+		if n < 1 {
+			n = 1
+		}
+		counts[first] += n
+	}
+	for _, d := range collapsed {
+		if counts[d] > 1 {
+			d.OccurrenceCount = counts[d]
+		}
+	}
+	return collapsed, nil
+}
+
+// appendOccurrenceCount appends OccurrenceCount to the message if it's more
+// than 1, e.g. "trailing whitespace (42 occurrences)".
+func appendOccurrenceCount(d *rdf.Diagnostic) {
+	if n := d.GetOccurrenceCount(); n > 1 {
+		d.Message += fmt.Sprintf(" (%d occurrences)", n)
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// confidenceRank returns the rank of confidence, which is higher for more
+// confident ones, or 0 if it's unknown.
+func confidenceRank(c string) int {
+	switch strings.ToUpper(c) {
+	case "HIGH":
+		return 3
+	case "MEDIUM":
+		return 2
+	case "LOW":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func hasCode(d *rdf.Diagnostic) bool {
+	return d.GetCode().GetValue() != ""
+}
+
+func unseen(seen map[string]bool) func(d *rdf.Diagnostic) bool {
+	return func(d *rdf.Diagnostic) bool {
+		fp := DiagnosticFingerprint(d, nil)
+		if seen[fp] {
+			return false
+		}
+		seen[fp] = true
+		// Keep the fingerprint of the undecorated diagnostic, which
+		// WriteFingerprintIndex and later runs reuse.
+		d.Fingerprint = fp
+		return true
+	}
+}
+
+func diffPosition(positions map[string]map[int]int) func(d *rdf.Diagnostic) {
+	return func(d *rdf.Diagnostic) {
+		line := int(d.GetLocation().GetRange().GetStart().GetLine())
+		if pos, ok := positions[d.GetLocation().GetPath()][line]; ok && line > 0 {
+			d.DiffPosition = int32(pos)
+			return
+		}
+		if d.Metadata == nil {
+			d.Metadata = make(map[string]string)
+		}
+		d.Metadata[NoDiffPositionMetadataKey] = "true"
+	}
+}
+
+func addMetadata(md map[string]string) func(d *rdf.Diagnostic) {
+	return func(d *rdf.Diagnostic) {
+		if d.Metadata == nil {
+			d.Metadata = make(map[string]string, len(md))
+		}
+		for k, v := range md {
+			if _, ok := d.Metadata[k]; !ok {
+				d.Metadata[k] = v
+			}
+		}
+	}
+}
+
+// addCorrelationID returns processStep which stores id in Metadata of each
+// diagnostic. A random id is generated for each call if id is empty.
+func addCorrelationID(id string) processStep {
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		cid := id
+		if cid == "" {
+			b := make([]byte, 16)
+			if _, err := io.ReadFull(rand.Reader, b); err != nil {
+				return nil, fmt.Errorf("failed to generate correlation id: %w", err)
+			}
+			cid = hex.EncodeToString(b)
+		}
+		for _, d := range ds {
+			if d.Metadata == nil {
+				d.Metadata = make(map[string]string)
+			}
+			d.Metadata[CorrelationIDMetadataKey] = cid
+		}
+		return ds, nil
+	}
+}
+
+// blameLine returns a function which stores the blame of the start line of
+// diagnostics with set.
+func blameLine(blame func(path string, line int) (string, error), set func(d *rdf.Diagnostic, s string)) func(d *rdf.Diagnostic) {
+	return func(d *rdf.Diagnostic) {
+		path := d.GetLocation().GetPath()
+		line := int(d.GetLocation().GetRange().GetStart().GetLine())
+		if path == "" || line == 0 {
+			return
+		}
+		if s, err := blame(path, line); err == nil {
+			set(d, s)
+		}
+	}
+}
+
+// sortSuggestions sorts suggestions by start position. Order of suggestions
+// starting at the same position is kept.
+func sortSuggestions(d *rdf.Diagnostic) {
+	sort.SliceStable(d.Suggestions, func(i, j int) bool {
+		a := d.Suggestions[i].GetRange().GetStart()
+		b := d.Suggestions[j].GetRange().GetStart()
+		if a.GetLine() != b.GetLine() {
+			return a.GetLine() < b.GetLine()
+		}
+		return a.GetColumn() < b.GetColumn()
+	})
+}
+
+// mergeContiguousSuggestions merges adjacent line-wise suggestions. Suggestions
+// with columns are kept as is since their text can't be simply concatenated.
+func mergeContiguousSuggestions(d *rdf.Diagnostic) {
+	if len(d.GetSuggestions()) < 2 {
+		return
+	}
+	merged := d.Suggestions[:1]
+	for _, s := range d.Suggestions[1:] {
+		prev := merged[len(merged)-1]
+		if isLinewise(prev.GetRange()) && isLinewise(s.GetRange()) &&
+			endLine(prev.GetRange())+1 == s.GetRange().GetStart().GetLine() {
+			merged[len(merged)-1] = &rdf.Suggestion{
+				Range: &rdf.Range{
+					Start: prev.GetRange().GetStart(),
+					End:   &rdf.Position{Line: endLine(s.GetRange())},
+				},
+				Text: prev.GetText() + "\n" + s.GetText(),
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	d.Suggestions = merged
+}
+
+// explodeMultiLineRange splits diagnostics with multi-line ranges into
+// diagnostics per line. The first line keeps the start column and the last
+// line keeps the end column. A last line whose end column is 1 isn't covered
+// as the end is exclusive.
+func explodeMultiLineRange(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	var exploded []*rdf.Diagnostic
+	for _, d := range ds {
+		rng := d.GetLocation().GetRange()
+		start, end := rng.GetStart(), rng.GetEnd()
+		last := end.GetLine()
+		if end.GetColumn() == 1 {
+			last--
+		}
+		if start.GetLine() == 0 || last <= start.GetLine() || last-start.GetLine()+1 > MaxExplodedLines {
+			exploded = append(exploded, d)
+			continue
+		}
+		for l := start.GetLine(); l <= last; l++ {
+			ld := proto.Clone(d).(*rdf.Diagnostic)
+			ld.Location.Range = &rdf.Range{Start: &rdf.Position{Line: l}}
+			if l == start.GetLine() {
+				ld.Location.Range.Start.Column = start.GetColumn()
+			} else {
+				ld.Suggestions = nil
+			}
+			if l == end.GetLine() && end.GetColumn() > 0 {
+				ld.Location.Range.End = &rdf.Position{Line: l, Column: end.GetColumn()}
+			}
+			exploded = append(exploded, ld)
+		}
+	}
+	return exploded, nil
+}
+
+// separateSuggestions splits diagnostics with suggestions into the diagnostic
+// without suggestions followed by one diagnostic per suggestion, located at
+// the suggestion range.
+func separateSuggestions(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	var separated []*rdf.Diagnostic
+	for _, d := range ds {
+		suggestions := d.GetSuggestions()
+		d.Suggestions = nil
+		separated = append(separated, d)
+		for _, s := range suggestions {
+			sd := proto.Clone(d).(*rdf.Diagnostic)
+			if sd.Location == nil {
+				sd.Location = &rdf.Location{}
+			}
+			// Copy the range not to share it with the suggestion, which
+			// location-only steps may modify.
+			if s.GetRange() != nil {
+				sd.Location.Range = proto.Clone(s.GetRange()).(*rdf.Range)
+			}
+			sd.Suggestions = []*rdf.Suggestion{s}
+			separated = append(separated, sd)
+		}
+	}
+	return separated, nil
+}
+
+// dedupSuggestions removes suggestions which duplicate earlier ones of any
+// diagnostic. Suggestions without range apply to the diagnostic range.
+func dedupSuggestions(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	type key struct {
+		path                     string
+		sline, scol, eline, ecol int32
+		text                     string
+	}
+	seen := make(map[key]bool)
+	for _, d := range ds {
+		if len(d.GetSuggestions()) == 0 {
+			continue
+		}
+		deduped := d.Suggestions[:0]
+		for _, s := range d.GetSuggestions() {
+			rng := s.GetRange()
+			if rng == nil {
+				rng = d.GetLocation().GetRange()
+			}
+			k := key{
+				path:  d.GetLocation().GetPath(),
+				sline: rng.GetStart().GetLine(), scol: rng.GetStart().GetColumn(),
+				eline: rng.GetEnd().GetLine(), ecol: rng.GetEnd().GetColumn(),
+				text: s.GetText(),
+			}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			deduped = append(deduped, s)
+		}
+		d.Suggestions = deduped
+	}
+	return ds, nil
+}
+
+// groupAndSort stably sorts diagnostics by the first occurrence of their
+// paths, then by line and column.
+func groupAndSort(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	order := make(map[string]int)
+	for _, d := range ds {
+		path := d.GetLocation().GetPath()
+		if _, ok := order[path]; !ok {
+			order[path] = len(order)
+		}
+	}
+	sort.SliceStable(ds, func(i, j int) bool {
+		li, lj := ds[i].GetLocation(), ds[j].GetLocation()
+		if oi, oj := order[li.GetPath()], order[lj.GetPath()]; oi != oj {
+			return oi < oj
+		}
+		si, sj := li.GetRange().GetStart(), lj.GetRange().GetStart()
+		if si.GetLine() != sj.GetLine() {
+			return si.GetLine() < sj.GetLine()
+		}
+		return si.GetColumn() < sj.GetColumn()
+	})
+	return ds, nil
+}
+
+// sortByFileMtime returns processStep which stably sorts diagnostics by
+// modification time of files in descending order, then by path and line.
+func sortByFileMtime(fs FileStater) processStep {
+	return func(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+		mtimes := make(map[string]time.Time)
+		for _, d := range ds {
+			path := d.GetLocation().GetPath()
+			if _, ok := mtimes[path]; ok || path == "" {
+				continue
+			}
+			var mtime time.Time // Zero for unknown, which sorts last.
+			if fi, err := fs.Stat(path); err == nil {
+				mtime = fi.ModTime()
+			}
+			mtimes[path] = mtime
+		}
+		sort.SliceStable(ds, func(i, j int) bool {
+			pi, pj := ds[i].GetLocation().GetPath(), ds[j].GetLocation().GetPath()
+			if ti, tj := mtimes[pi], mtimes[pj]; !ti.Equal(tj) {
+				return ti.After(tj)
+			}
+			if pi != pj {
+				return pi < pj
+			}
+			return ds[i].GetLocation().GetRange().GetStart().GetLine() < ds[j].GetLocation().GetRange().GetStart().GetLine()
+		})
+		return ds, nil
+	}
+}
+
+var severityEmoji = map[rdf.Severity]string{
+	rdf.Severity_ERROR:   "❌",
+	rdf.Severity_WARNING: "⚠️",
+	rdf.Severity_INFO:    "ℹ️",
+}
+
+func prefixSeverityEmoji(d *rdf.Diagnostic) {
+	if emoji, ok := severityEmoji[d.GetSeverity()]; ok {
+		d.Message = emoji + " " + d.Message
+	}
+}
+
+func reverseDiagnostics(ds []*rdf.Diagnostic) ([]*rdf.Diagnostic, error) {
+	for i, j := 0, len(ds)-1; i < j; i, j = i+1, j-1 {
+		ds[i], ds[j] = ds[j], ds[i]
+	}
+	return ds, nil
+}
+
+// isLinewise reports whether the range is line-wise (no columns).
+func isLinewise(rng *rdf.Range) bool {
+	return rng.GetStart().GetLine() > 0 && rng.GetStart().GetColumn() == 0 && rng.GetEnd().GetColumn() == 0
+}
+
+// endLine returns end line of the range. End defaults to start.
+func endLine(rng *rdf.Range) int32 {
+	if rng.GetEnd() != nil {
+		return rng.GetEnd().GetLine()
+	}
+	return rng.GetStart().GetLine()
+}
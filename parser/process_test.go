@@ -0,0 +1,1689 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func TestProcessor_UnescapeLiteralSequences(t *testing.T) {
+	const sample = `{"message":"line1\\nline2\\tindented\\r","location":{"path":"a.go"}}`
+	tests := []struct {
+		unescape bool
+		want     string
+	}{
+		{unescape: false, want: `line1\nline2\tindented\r`},
+		{unescape: true, want: "line1\nline2\tindented\r"},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "rdjsonl", UnescapeLiteralSequences: tt.unescape})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ds[0].GetMessage(); got != tt.want {
+			t.Errorf("UnescapeLiteralSequences=%v: got %q, want %q", tt.unescape, got, tt.want)
+		}
+	}
+}
+
+func TestProcessor_CollapseWhitespace(t *testing.T) {
+	const sample = `{"message":"  Expected indentation:\n      want 4 spaces\n\t  got 2 \n","location":{"path":"a.go"}}`
+	tests := []struct {
+		collapse bool
+		want     string
+	}{
+		{collapse: false, want: "  Expected indentation:\n      want 4 spaces\n\t  got 2 \n"},
+		{collapse: true, want: "Expected indentation: want 4 spaces got 2"},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "rdjsonl", CollapseWhitespace: tt.collapse})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ds[0].GetMessage(); got != tt.want {
+			t.Errorf("CollapseWhitespace=%v: got %q, want %q", tt.collapse, got, tt.want)
+		}
+	}
+}
+
+func TestProcessor_ToSlash(t *testing.T) {
+	const sample = `{"message":"msg","location":{"path":"src\\app\\main.go"},"related_locations":[{"location":{"path":"src\\lib.go"}}]}`
+	p, err := New(&Option{FormatName: "rdjsonl", ToSlash: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Backslashes are valid in file names except on Windows.
+	wantPath, wantRelated := `src\app\main.go`, `src\lib.go`
+	if runtime.GOOS == "windows" {
+		wantPath, wantRelated = "src/app/main.go", "src/lib.go"
+	}
+	if got := ds[0].GetLocation().GetPath(); got != wantPath {
+		t.Errorf("got path %q, want %q", got, wantPath)
+	}
+	if got := ds[0].GetRelatedLocations()[0].GetLocation().GetPath(); got != wantRelated {
+		t.Errorf("got related path %q, want %q", got, wantRelated)
+	}
+}
+
+func TestProcessor_ChangedFiles(t *testing.T) {
+	const sample = `{"message":"changed","location":{"path":"./src/a.go"}}
+{"message":"untouched","location":{"path":"src/b.go"}}
+{"message":"changed too","location":{"path":"src/c.go"}}
+{"message":"run-level"}`
+	p, err := New(&Option{FormatName: "rdjsonl", ChangedFiles: []string{"src/a.go", "./src/c.go"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"changed", "changed too", "run-level"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("messages (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_DefaultPath(t *testing.T) {
+	const sample = `{"message":"invalid config","severity":"ERROR"}
+{"message":"with range","location":{"range":{"start":{"line":3}}}}
+{"message":"with path","location":{"path":"a.go"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", DefaultPath: ".golangci.yml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Location{
+		{Path: ".golangci.yml"},
+		{Path: ".golangci.yml", Range: &rdf.Range{Start: &rdf.Position{Line: 3}}},
+		{Path: "a.go"},
+	}
+	var got []*rdf.Location
+	for _, d := range ds {
+		got = append(got, d.GetLocation())
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("locations (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_PathPrefixMap(t *testing.T) {
+	const sample = `<?xml version="1.0" encoding="utf-8"?><checkstyle version="4.3">
+<file name="build/api/main.go"><error line="1" column="1" severity="error" message="msg1" source="src" /></file>
+<file name="build/web/app.js"><error line="2" column="1" severity="error" message="msg2" source="src" /></file>
+<file name="build/lib.go"><error line="3" column="1" severity="error" message="msg3" source="src" /></file>
+<file name="other/main.go"><error line="4" column="1" severity="error" message="msg4" source="src" /></file>
+</checkstyle>`
+	p, err := New(&Option{
+		FormatName: "checkstyle",
+		PathPrefixMap: map[string]string{
+			"build/":     "services/",
+			"build/web/": "frontend/",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"services/api/main.go",
+		"frontend/app.js",
+		"services/lib.go",
+		"other/main.go",
+	}
+	if len(ds) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d", len(ds), len(want))
+	}
+	for i, d := range ds {
+		if got := d.GetLocation().GetPath(); got != want[i] {
+			t.Errorf("%d: got %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestProcessor_CRLFColumnFix(t *testing.T) {
+	// Columns are reported by a tool which counts "\r\n" as two characters.
+	const sample = `{"message":"before CR","location":{"path":"crlf.txt","range":{"start":{"line":1,"column":3}}}}
+{"message":"on CR","location":{"path":"crlf.txt","range":{"start":{"line":1,"column":4}}}}
+{"message":"past CR","location":{"path":"crlf.txt","range":{"start":{"line":2,"column":1},"end":{"line":2,"column":5}}}}
+{"message":"LF line","location":{"path":"crlf.txt","range":{"start":{"line":3,"column":4}}}}
+{"message":"unknown file","location":{"path":"unknown.txt","range":{"start":{"line":1,"column":4}}}}`
+	fr := fakeFileReader{"crlf.txt": "abc\r\ndef\r\nghi\n"}
+
+	if _, err := New(&Option{FormatName: "rdjsonl", CRLFColumnFix: true}); err == nil {
+		t.Error("want error without FileReader")
+	}
+	p, err := New(&Option{FormatName: "rdjsonl", CRLFColumnFix: true, FileReader: fr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ start, end int32 }{
+		{start: 3},
+		{start: 3},
+		{start: 1, end: 4},
+		{start: 4},
+		{start: 4},
+	}
+	for i, d := range ds {
+		rng := d.GetLocation().GetRange()
+		if got := rng.GetStart().GetColumn(); got != want[i].start {
+			t.Errorf("%s: start column: got %d, want %d", d.GetMessage(), got, want[i].start)
+		}
+		if got := rng.GetEnd().GetColumn(); got != want[i].end {
+			t.Errorf("%s: end column: got %d, want %d", d.GetMessage(), got, want[i].end)
+		}
+	}
+}
+
+func TestProcessor_ColumnsAreRunes(t *testing.T) {
+	// Columns are reported by a tool which counts runes.
+	const sample = `{"message":"after emoji","location":{"path":"emoji.txt","range":{"start":{"line":1,"column":3},"end":{"line":1,"column":6}}}}
+{"message":"ASCII line","location":{"path":"emoji.txt","range":{"start":{"line":2,"column":2}}}}
+{"message":"end of line","location":{"path":"emoji.txt","range":{"start":{"line":1,"column":7}}},"suggestions":[{"range":{"start":{"line":1,"column":2},"end":{"line":1,"column":3}},"text":""}]}`
+	fr := fakeFileReader{"emoji.txt": "a😀bécd\nabc\n"}
+
+	runes, bytes := true, false
+	if _, err := New(&Option{FormatName: "rdjsonl", ColumnsAreRunes: &runes}); err == nil {
+		t.Error("want error without FileReader")
+	}
+	// Nothing to convert without FileReader by default.
+	if _, err := New(&Option{FormatName: "rdjsonl"}); err != nil {
+		t.Errorf("got unexpected error without FileReader by default: %v", err)
+	}
+
+	// Columns are kept as is for tools which report byte columns.
+	p, err := New(&Option{FormatName: "rdjsonl", ColumnsAreRunes: &bytes, FileReader: fr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ds[0].GetLocation().GetRange().GetStart().GetColumn(); got != 3 {
+		t.Errorf("byte start column: got %d, want 3", got)
+	}
+
+	for _, opt := range []*Option{
+		{FormatName: "rdjsonl", FileReader: fr}, // Default.
+		{FormatName: "rdjsonl", ColumnsAreRunes: &runes, FileReader: fr},
+	} {
+		p, err := New(opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// "a😀bécd": a=1, 😀=2 (4 bytes), b=6, é=7 (2 bytes), c=9, d=10, EOL=11.
+		want := []struct{ start, end int32 }{
+			{start: 6, end: 10},
+			{start: 2},
+			{start: 11},
+		}
+		for i, d := range ds {
+			rng := d.GetLocation().GetRange()
+			if got := rng.GetStart().GetColumn(); got != want[i].start {
+				t.Errorf("%s: start column: got %d, want %d", d.GetMessage(), got, want[i].start)
+			}
+			if got := rng.GetEnd().GetColumn(); got != want[i].end {
+				t.Errorf("%s: end column: got %d, want %d", d.GetMessage(), got, want[i].end)
+			}
+		}
+		if rng := ds[2].GetSuggestions()[0].GetRange(); rng.GetStart().GetColumn() != 2 || rng.GetEnd().GetColumn() != 6 {
+			t.Errorf("suggestion range: got %v, want columns 2-6", rng)
+		}
+	}
+}
+
+func TestProcessor_PathManifest(t *testing.T) {
+	const sample = `{"message":"generated","location":{"path":"/build/gen/api.pb.go"}}
+{"message":"not generated","location":{"path":"/build/main.go"}}`
+	p, err := New(&Option{
+		FormatName:    "rdjsonl",
+		PathPrefixMap: map[string]string{"/build/": ""},
+		PathManifest:  map[string]string{"gen/api.pb.go": "proto/api.proto"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"proto/api.proto", "main.go"}
+	for i, d := range ds {
+		if got := d.GetLocation().GetPath(); got != want[i] {
+			t.Errorf("%s: got %q, want %q", d.GetMessage(), got, want[i])
+		}
+	}
+}
+
+func TestProcessor_RequireExistingPaths(t *testing.T) {
+	const sample = `{"message":"exists","location":{"path":"a.go"}}
+{"message":"missing","location":{"path":"missing.go"}}
+{"message":"no path"}`
+	fr := fakeFileReader{"a.go": "package a\n"}
+
+	if _, err := New(&Option{FormatName: "rdjsonl", RequireExistingPaths: true}); err == nil {
+		t.Error("want error without FileReader")
+	}
+	p, err := New(&Option{FormatName: "rdjsonl", RequireExistingPaths: true, FileReader: fr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"exists", "no path"}
+	if len(ds) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d", len(ds), len(want))
+	}
+	for i, d := range ds {
+		if got := d.GetMessage(); got != want[i] {
+			t.Errorf("%d: got %q, want %q", i, got, want[i])
+		}
+	}
+
+	p, err = New(&Option{FormatName: "rdjsonl", RequireExistingPaths: true, FailOnMissingPaths: true, FileReader: fr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Parse(strings.NewReader(sample)); err == nil || !strings.Contains(err.Error(), "missing.go") {
+		t.Errorf("got %v, want error about missing.go", err)
+	}
+}
+
+func TestProcessor_MessageRewrites(t *testing.T) {
+	const sample = `{"message":"exported function Foo should have comment or be unexported","location":{"path":"a.go"}}
+{"message":"line is 130 characters","location":{"path":"a.go"}}
+{"message":"line is 130 characters (lll)","location":{"path":"a.go"}}
+{"message":"unchanged","location":{"path":"a.go"}}`
+	p, err := New(&Option{
+		FormatName: "rdjsonl",
+		MessageRewrites: map[string]string{
+			"exported function Foo should have comment or be unexported": "Document Foo",
+			"line is 130 characters": "Line too long",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"Document Foo", "Line too long", "line is 130 characters (lll)", "unchanged"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("messages (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_IgnoreMessagePatterns(t *testing.T) {
+	const sample = `{"message":"exported function Foo should have comment or be unexported","location":{"path":"a.go"}}
+{"message":"Error return value of os.Remove is not checked","location":{"path":"a.go"}}
+{"message":"Error return value of os.Open is not checked","location":{"path":"a.go"}}
+{"message":"line is 130 characters","location":{"path":"a.go"}}`
+	opt := &Option{FormatName: "rdjsonl", IgnoreMessagePatterns: []string{`^exported \w+ \w+ should have comment`, `os\.Remove`}}
+	p, err := New(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"Error return value of os.Open is not checked", "line is 130 characters"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("messages (-want +got):\n%s", diff)
+	}
+
+	opt.IgnoreMessagePatterns = []string{"("}
+	if _, err := New(opt); err == nil {
+		t.Error("want error for invalid pattern")
+	}
+}
+
+func TestProcessor_SeverityEmoji(t *testing.T) {
+	const sample = `{"message":"error","severity":"ERROR","location":{"path":"a.go"}}
+{"message":"warning","severity":"WARNING","location":{"path":"a.go"}}
+{"message":"info","severity":"INFO","location":{"path":"a.go"}}
+{"message":"unknown","location":{"path":"a.go"}}`
+	seen := make(map[string]bool)
+	p, err := New(&Option{FormatName: "rdjsonl", SeverityEmoji: true, SeenFingerprints: seen})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"❌ error", "⚠️ warning", "ℹ️ info", "unknown"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("messages (-want +got):\n%s", diff)
+	}
+	d := &rdf.Diagnostic{Message: "error", Location: &rdf.Location{Path: "a.go"}}
+	if !seen[DiagnosticFingerprint(d, nil)] {
+		t.Error("fingerprints should be computed without emoji")
+	}
+}
+
+func TestProcessor_ForceSingleLineRange(t *testing.T) {
+	const sample = `{"message":"multi-line","location":{"path":"a.go","range":{"start":{"line":1,"column":3},"end":{"line":3,"column":2}}},"suggestions":[{"range":{"start":{"line":1,"column":3},"end":{"line":3,"column":2}},"text":""}]}
+{"message":"line-wise","location":{"path":"a.go","range":{"start":{"line":2},"end":{"line":3}}}}
+{"message":"single line","location":{"path":"a.go","range":{"start":{"line":2,"column":1},"end":{"line":2,"column":3}}}}
+{"message":"unknown file","location":{"path":"unknown.go","range":{"start":{"line":1,"column":3},"end":{"line":2,"column":1}}}}`
+	fr := fakeFileReader{"a.go": "abcdef\r\nghi\njkl\n"}
+	p, err := New(&Option{FormatName: "rdjsonl", ForceSingleLineRange: true, FileReader: fr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Range{
+		{Start: &rdf.Position{Line: 1, Column: 3}, End: &rdf.Position{Line: 1, Column: 7}},
+		{Start: &rdf.Position{Line: 2}, End: &rdf.Position{Line: 2}},
+		{Start: &rdf.Position{Line: 2, Column: 1}, End: &rdf.Position{Line: 2, Column: 3}},
+		{Start: &rdf.Position{Line: 1, Column: 3}},
+	}
+	for i, d := range ds {
+		if diff := cmp.Diff(want[i], d.GetLocation().GetRange(), protocmp.Transform()); diff != "" {
+			t.Errorf("%s: range diff (-want +got):\n%s", d.GetMessage(), diff)
+		}
+	}
+	if got := ds[0].GetSuggestions()[0].GetRange().GetEnd().GetLine(); got != 3 {
+		t.Errorf("suggestion range should be kept: got end line %d, want 3", got)
+	}
+}
+
+func TestProcessor_FullLineRange(t *testing.T) {
+	const sample = `{"message":"line only","location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"lines","location":{"path":"a.go","range":{"start":{"line":2},"end":{"line":3}}}}
+{"message":"has columns","location":{"path":"a.go","range":{"start":{"line":2,"column":2}}}}
+{"message":"out of file","location":{"path":"a.go","range":{"start":{"line":10}}}}
+{"message":"unknown file","location":{"path":"unknown.go","range":{"start":{"line":1}}}}`
+	fr := fakeFileReader{"a.go": "abcdef\r\nghi\njk\n"}
+	opt := &Option{FormatName: "rdjsonl", FullLineRange: true}
+
+	if _, err := New(opt); err == nil {
+		t.Error("want error without FileReader")
+	}
+	opt.FileReader = fr
+	p, err := New(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Range{
+		{Start: &rdf.Position{Line: 1, Column: 1}, End: &rdf.Position{Line: 1, Column: 7}},
+		{Start: &rdf.Position{Line: 2, Column: 1}, End: &rdf.Position{Line: 3, Column: 3}},
+		{Start: &rdf.Position{Line: 2, Column: 2}},
+		{Start: &rdf.Position{Line: 10}},
+		{Start: &rdf.Position{Line: 1}},
+	}
+	for i, d := range ds {
+		if diff := cmp.Diff(want[i], d.GetLocation().GetRange(), protocmp.Transform()); diff != "" {
+			t.Errorf("%s: range diff (-want +got):\n%s", d.GetMessage(), diff)
+		}
+	}
+}
+
+func TestProcessor_LocateByPattern(t *testing.T) {
+	const sample = `{"message":"located","code":{"value":"no-todo"},"location":{"path":"a.go"}}
+{"message":"no match","code":{"value":"no-fixme"},"location":{"path":"a.go"}}
+{"message":"other code","code":{"value":"other"},"location":{"path":"a.go"}}
+{"message":"has line","code":{"value":"no-todo"},"location":{"path":"a.go","range":{"start":{"line":1}}}}`
+	fr := fakeFileReader{"a.go": "package a\n\n// TODO: fix\n// TODO: again\n"}
+	opt := &Option{
+		FormatName:      "rdjsonl",
+		LocateByPattern: map[string]string{"no-todo": `TODO`, "no-fixme": `FIXME`},
+	}
+
+	if _, err := New(opt); err == nil {
+		t.Error("want error without FileReader")
+	}
+	opt.FileReader = fr
+	p, err := New(opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int32{3, 0, 0, 1}
+	for i, d := range ds {
+		if got := d.GetLocation().GetRange().GetStart().GetLine(); got != want[i] {
+			t.Errorf("%s: got line %d, want %d", d.GetMessage(), got, want[i])
+		}
+	}
+}
+
+func TestProcessor_DropSeverities(t *testing.T) {
+	const sample = `<?xml version="1.0" encoding="utf-8"?>
+<checkstyle version="4.3">
+  <file name="a.js">
+    <error line="1" column="1" severity="error" message="error" source="rule1" />
+    <error line="2" column="1" severity="info" message="info" source="rule2" />
+    <error line="3" column="1" severity="ignore" message="ignore" source="rule3" />
+    <error line="4" column="1" severity="INFO" message="upper info" source="rule4" />
+    <error line="5" column="1" severity="note" message="note" source="rule5" />
+  </file>
+</checkstyle>`
+	p, err := New(&Option{FormatName: "checkstyle", DropSeverities: []string{"Info", "ignore"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// "note" is mapped to INFO and dropped as well.
+	want := []string{"error"}
+	if len(ds) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d", len(ds), len(want))
+	}
+	for i, d := range ds {
+		if got := d.GetMessage(); got != want[i] {
+			t.Errorf("%d: got %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestProcessor_RequireCode(t *testing.T) {
+	const sample = `{"message":"with code","code":{"value":"rule1"}}
+{"message":"without code"}
+{"message":"empty code","code":{"url":"https://example.com"}}
+{"message":"with code 2","code":{"value":"rule2"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", RequireCode: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"with code", "with code 2"}
+	if len(ds) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d", len(ds), len(want))
+	}
+	for i, d := range ds {
+		if got := d.GetMessage(); got != want[i] {
+			t.Errorf("%d: got %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestProcessor_DedupByLocation(t *testing.T) {
+	const sample = `{"message":"unused variable x","severity":"WARNING","location":{"path":"a.go","range":{"start":{"line":1,"column":5}}}}
+{"message":"x declared but not used","severity":"ERROR","location":{"path":"a.go","range":{"start":{"line":1,"column":5}}}}
+{"message":"unused variable x","severity":"INFO","location":{"path":"a.go","range":{"start":{"line":1,"column":5}}}}
+{"message":"other column","location":{"path":"a.go","range":{"start":{"line":1,"column":6}}}}`
+	p, err := New(&Option{FormatName: "rdjsonl", DedupByLocation: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(ds))
+	}
+	if got, want := ds[0].GetMessage(), "unused variable x\nx declared but not used"; got != want {
+		t.Errorf("got message %q, want %q", got, want)
+	}
+	if got := ds[0].GetSeverity(); got != rdf.Severity_ERROR {
+		t.Errorf("got severity %v, want ERROR", got)
+	}
+	if got := ds[1].GetMessage(); got != "other column" {
+		t.Errorf("got message %q, want %q", got, "other column")
+	}
+}
+
+func TestProcessor_DedupByLocation_noRange(t *testing.T) {
+	const sample = `{"message":"missing license header","severity":"WARNING","location":{"path":"a.go"}}
+{"message":"file too long","severity":"ERROR","location":{"path":"a.go"}}
+{"message":"no location"}
+{"message":"no location either"}`
+	p, err := New(&Option{FormatName: "rdjsonl", DedupByLocation: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"missing license header", "file too long", "no location", "no location either"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("messages (-want +got):\n%s", diff)
+	}
+	if got := ds[0].GetSeverity(); got != rdf.Severity_WARNING {
+		t.Errorf("got severity %v, want WARNING", got)
+	}
+}
+
+func TestProcessor_SeenFingerprints(t *testing.T) {
+	const sample = `{"message":"seen","fingerprint":"fp1"}
+{"message":"new","fingerprint":"fp2"}
+{"message":"no fingerprint"}
+{"message":"new","fingerprint":"fp2"}`
+	seen := map[string]bool{"fp1": true}
+	p, err := New(&Option{FormatName: "rdjsonl", SeenFingerprints: seen})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"new", "no fingerprint"}
+	if len(ds) != len(want) {
+		t.Fatalf("got %d diagnostics, want %d", len(ds), len(want))
+	}
+	for i, d := range ds {
+		if got := d.GetMessage(); got != want[i] {
+			t.Errorf("%d: got %q, want %q", i, got, want[i])
+		}
+	}
+	if !seen["fp2"] || !seen[DiagnosticFingerprint(ds[1], nil)] {
+		t.Errorf("new fingerprints are not recorded: %v", seen)
+	}
+
+	// Everything is reported in the previous call.
+	ds, err = p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 0 {
+		t.Errorf("got %d diagnostics in the second call, want 0", len(ds))
+	}
+}
+
+func TestProcessor_ExtraMetadata(t *testing.T) {
+	const sample = `{"message":"msg1"}
+{"message":"msg2","metadata":{"tool":"golint"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", ExtraMetadata: map[string]string{"tool": "reviewdog", "run": "42"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]string{
+		{"tool": "reviewdog", "run": "42"},
+		{"tool": "golint", "run": "42"},
+	}
+	for i, d := range ds {
+		if diff := cmp.Diff(want[i], d.GetMetadata()); diff != "" {
+			t.Errorf("%s: metadata diff (-want +got):\n%s", d.GetMessage(), diff)
+		}
+	}
+}
+
+func TestProcessor_SortSuggestions(t *testing.T) {
+	const sample = `{"message":"msg","suggestions":[` +
+		`{"range":{"start":{"line":3,"column":5}},"text":"c"},` +
+		`{"range":{"start":{"line":1,"column":2}},"text":"a"},` +
+		`{"range":{"start":{"line":3,"column":1}},"text":"b"},` +
+		`{"range":{"start":{"line":1,"column":2}},"text":"a2"}]}`
+	p, err := New(&Option{FormatName: "rdjsonl", SortSuggestions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, s := range ds[0].GetSuggestions() {
+		got = append(got, s.GetText())
+	}
+	if diff := cmp.Diff([]string{"a", "a2", "b", "c"}, got); diff != "" {
+		t.Errorf("suggestions order diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_FailOnSeverity(t *testing.T) {
+	const sample = `{"message":"info","severity":"INFO"}
+{"message":"warning","severity":"WARNING"}
+{"message":"unknown"}`
+	tests := []struct {
+		threshold rdf.Severity
+		wantErr   bool
+	}{
+		{threshold: rdf.Severity_ERROR, wantErr: false},
+		{threshold: rdf.Severity_WARNING, wantErr: true},
+		{threshold: rdf.Severity_INFO, wantErr: true},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "rdjsonl", FailOnSeverity: tt.threshold})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if got := errors.Is(err, ErrThresholdExceeded); got != tt.wantErr {
+			t.Errorf("FailOnSeverity=%v: got error %v, want ErrThresholdExceeded: %v", tt.threshold, err, tt.wantErr)
+		}
+		if len(ds) != 3 {
+			t.Errorf("FailOnSeverity=%v: got %d diagnostics, want all 3", tt.threshold, len(ds))
+		}
+	}
+}
+
+func TestProcessor_CodeTrimSuffix(t *testing.T) {
+	const sample = `{"message":"msg1","code":{"value":"no-unused-vars/recommended","url":"https://example.com/no-unused-vars"}}
+{"message":"msg2","code":{"value":"no-undef"}}
+{"message":"msg3"}`
+	p, err := New(&Option{FormatName: "rdjsonl", CodeTrimSuffix: "/recommended"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Code{
+		{Value: "no-unused-vars", Url: "https://example.com/no-unused-vars"},
+		{Value: "no-undef"},
+		nil,
+	}
+	for i, d := range ds {
+		if diff := cmp.Diff(want[i], d.GetCode(), protocmp.Transform()); diff != "" {
+			t.Errorf("%s: code diff (-want +got):\n%s", d.GetMessage(), diff)
+		}
+	}
+}
+
+func TestProcessor_SeparateSuggestions(t *testing.T) {
+	const sample = `{"message":"two fixes","location":{"path":"a.go","range":{"start":{"line":1}}},"suggestions":[` +
+		`{"range":{"start":{"line":1,"column":1},"end":{"line":1,"column":3}},"text":"x"},` +
+		`{"range":{"start":{"line":2,"column":1},"end":{"line":2,"column":3}},"text":"y"}]}
+{"message":"no fix","location":{"path":"b.go"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", SeparateSuggestions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sugg := func(line int32, text string) *rdf.Suggestion {
+		return &rdf.Suggestion{
+			Range: &rdf.Range{Start: &rdf.Position{Line: line, Column: 1}, End: &rdf.Position{Line: line, Column: 3}},
+			Text:  text,
+		}
+	}
+	want := []*rdf.Diagnostic{
+		{Message: "two fixes", Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 1}}}},
+		{Message: "two fixes", Location: &rdf.Location{Path: "a.go", Range: sugg(1, "x").Range}, Suggestions: []*rdf.Suggestion{sugg(1, "x")}},
+		{Message: "two fixes", Location: &rdf.Location{Path: "a.go", Range: sugg(2, "y").Range}, Suggestions: []*rdf.Suggestion{sugg(2, "y")}},
+		{Message: "no fix", Location: &rdf.Location{Path: "b.go"}},
+	}
+	if diff := cmp.Diff(want, ds, protocmp.Transform(),
+		protocmp.IgnoreFields(&rdf.Diagnostic{}, "original_output", "format")); diff != "" {
+		t.Errorf("diagnostics diff (-want +got):\n%s", diff)
+	}
+}
+
+// slowReader returns one line per Read and blocks forever after lines run out.
+type slowReader struct {
+	lines []string
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if len(r.lines) == 0 {
+		select {} // Block forever to simulate a stuck producer.
+	}
+	n := copy(p, r.lines[0]+"\n")
+	r.lines = r.lines[1:]
+	return n, nil
+}
+
+func TestProcessor_MaxParseDuration(t *testing.T) {
+	r := &slowReader{lines: []string{
+		`{"message":"msg1"}`,
+		`{"message":"msg2"}`,
+	}}
+	p, err := New(&Option{FormatName: "rdjsonl", MaxParseDuration: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(r)
+	if !errors.Is(err, ErrMaxParseDurationExceeded) {
+		t.Errorf("got error %v, want ErrMaxParseDurationExceeded", err)
+	}
+	if len(ds) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(ds))
+	}
+	for i, d := range ds {
+		if got, want := d.GetMessage(), fmt.Sprintf("msg%d", i+1); got != want {
+			t.Errorf("%d: got %q, want %q", i, got, want)
+		}
+	}
+
+	// It doesn't affect parsing which finishes in time.
+	ds, err = p.Parse(strings.NewReader(`{"message":"msg1"}`))
+	if err != nil {
+		t.Errorf("got unexpected error: %v", err)
+	}
+	if len(ds) != 1 {
+		t.Errorf("got %d diagnostics, want 1", len(ds))
+	}
+}
+
+func TestProcessor_BlameFunc(t *testing.T) {
+	const sample = `{"message":"msg1","location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"msg2","location":{"path":"a.go","range":{"start":{"line":2}}}}
+{"message":"no line","location":{"path":"a.go"}}
+{"message":"untracked","location":{"path":"untracked.go","range":{"start":{"line":1}}}}`
+	blame := func(path string, line int) (string, error) {
+		if path != "a.go" {
+			return "", errors.New("no such path in HEAD")
+		}
+		return fmt.Sprintf("author%d", line), nil
+	}
+	p, err := New(&Option{FormatName: "rdjsonl", BlameFunc: blame})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"author1", "author2", "", ""}
+	for i, d := range ds {
+		if got := d.GetBlameAuthor(); got != want[i] {
+			t.Errorf("%s: got %q, want %q", d.GetMessage(), got, want[i])
+		}
+	}
+}
+
+func TestProcessor_BlameEmailFunc(t *testing.T) {
+	const sample = `{"message":"msg1","location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"untracked","location":{"path":"untracked.go","range":{"start":{"line":1}}}}`
+	blame := func(path string, line int) (string, error) {
+		return "alice", nil
+	}
+	blameEmail := func(path string, line int) (string, error) {
+		if path != "a.go" {
+			return "", errors.New("no such path in HEAD")
+		}
+		return fmt.Sprintf("author%d@example.com", line), nil
+	}
+	p, err := New(&Option{FormatName: "rdjsonl", BlameFunc: blame, BlameEmailFunc: blameEmail})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"author1@example.com", ""}
+	for i, d := range ds {
+		if got := d.GetBlameEmail(); got != want[i] {
+			t.Errorf("%s: got email %q, want %q", d.GetMessage(), got, want[i])
+		}
+		if got := d.GetBlameAuthor(); got != "alice" {
+			t.Errorf("%s: got author %q, want %q", d.GetMessage(), got, "alice")
+		}
+	}
+}
+
+func TestProcessor_PathRegexp(t *testing.T) {
+	const sample = `{"message":"msg1","location":{"path":"out/build-1a2b3c/pkg/main.go"}}
+{"message":"msg2","location":{"path":"out/build-ff00/lib.go"}}
+{"message":"msg3","location":{"path":"src/build-tools/main.go"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", PathRegexp: `^out/build-[0-9a-f]+/`, PathReplace: "src/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"src/pkg/main.go", "src/lib.go", "src/build-tools/main.go"}
+	for i, d := range ds {
+		if got := d.GetLocation().GetPath(); got != want[i] {
+			t.Errorf("%d: got %q, want %q", i, got, want[i])
+		}
+	}
+
+	if _, err := New(&Option{FormatName: "rdjsonl", PathRegexp: `build-(`}); err == nil {
+		t.Error("want error for invalid PathRegexp")
+	}
+}
+
+func TestProcessor_MergeContiguousSuggestions(t *testing.T) {
+	const sample = `{"message":"fix","location":{"path":"a.go","range":{"start":{"line":1}}},"suggestions":[` +
+		`{"range":{"start":{"line":1},"end":{"line":1}},"text":"line1"},` +
+		`{"range":{"start":{"line":2},"end":{"line":2}},"text":"line2"},` +
+		`{"range":{"start":{"line":3}},"text":"line3"},` +
+		`{"range":{"start":{"line":5},"end":{"line":5}},"text":"line5"},` +
+		`{"range":{"start":{"line":6,"column":1},"end":{"line":6,"column":3}},"text":"xx"}]}`
+	p, err := New(&Option{FormatName: "rdjsonl", MergeContiguousSuggestions: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Suggestion{
+		{
+			Range: &rdf.Range{Start: &rdf.Position{Line: 1}, End: &rdf.Position{Line: 3}},
+			Text:  "line1\nline2\nline3",
+		},
+		{
+			Range: &rdf.Range{Start: &rdf.Position{Line: 5}, End: &rdf.Position{Line: 5}},
+			Text:  "line5",
+		},
+		{
+			Range: &rdf.Range{Start: &rdf.Position{Line: 6, Column: 1}, End: &rdf.Position{Line: 6, Column: 3}},
+			Text:  "xx",
+		},
+	}
+	if diff := cmp.Diff(ds[0].GetSuggestions(), want, protocmp.Transform()); diff != "" {
+		t.Errorf("suggestions (-got +want):\n%s", diff)
+	}
+}
+
+// fakeStatFileReader is FileReader which reports modification times of
+// files.
+type fakeStatFileReader struct {
+	fakeFileReader
+	mtimes map[string]time.Time
+}
+
+func (f fakeStatFileReader) Stat(path string) (os.FileInfo, error) {
+	mtime, ok := f.mtimes[path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return fakeFileInfo{mtime: mtime}, nil
+}
+
+type fakeFileInfo struct {
+	os.FileInfo
+	mtime time.Time
+}
+
+func (fi fakeFileInfo) ModTime() time.Time { return fi.mtime }
+
+func TestProcessor_SortByFileMtime(t *testing.T) {
+	const sample = `{"message":"old:2","location":{"path":"old.go","range":{"start":{"line":2}}}}
+{"message":"unknown:1","location":{"path":"unknown.go","range":{"start":{"line":1}}}}
+{"message":"new:5","location":{"path":"new.go","range":{"start":{"line":5}}}}
+{"message":"old:1","location":{"path":"old.go","range":{"start":{"line":1}}}}
+{"message":"new:3 first","location":{"path":"new.go","range":{"start":{"line":3}}}}
+{"message":"same:1","location":{"path":"same.go","range":{"start":{"line":1}}}}
+{"message":"new:3 second","location":{"path":"new.go","range":{"start":{"line":3}}}}`
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fr := fakeStatFileReader{mtimes: map[string]time.Time{
+		"old.go":  now.Add(-time.Hour),
+		"new.go":  now,
+		"same.go": now.Add(-time.Hour),
+	}}
+
+	if _, err := New(&Option{FormatName: "rdjsonl", SortByFileMtime: true, FileReader: fakeFileReader{}}); err == nil {
+		t.Error("want error with FileReader which doesn't implement FileStater")
+	}
+	p, err := New(&Option{FormatName: "rdjsonl", SortByFileMtime: true, FileReader: fr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"new:3 first", "new:3 second", "new:5", "old:1", "old:2", "same:1", "unknown:1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("order (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_EmitParseMeta(t *testing.T) {
+	tests := []struct {
+		opt   *Option
+		input string
+		want  string // Message of the meta diagnostic. Empty for none.
+	}{
+		{
+			opt:   &Option{Errorformat: []string{"%f:%l: %m"}},
+			input: "Running linters...\nall good\ndone",
+			want:  "errorformat matched 0 of 3 lines",
+		},
+		{
+			opt:   &Option{FormatName: "rdjson"},
+			input: `{"source":{"name":"linter"},"diagnostics":[]}`,
+			want:  "rdjson input contained no diagnostics",
+		},
+		{
+			opt:   &Option{FormatName: "tfsec"},
+			input: `{"results":null}`,
+			want:  "tfsec input contained no diagnostics",
+		},
+		{
+			opt:   &Option{Errorformat: []string{"%f:%l: %m"}},
+			input: "a.go:1: msg\n",
+		},
+	}
+	for _, tt := range tests {
+		tt.opt.EmitParseMeta = true
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.want == "" {
+			if len(ds) != 1 || ds[0].GetMessage() != "msg" {
+				t.Errorf("%q: want only parsed diagnostic, got %v", tt.input, ds)
+			}
+			continue
+		}
+		if len(ds) != 1 {
+			t.Fatalf("%q: got %d diagnostics, want 1", tt.input, len(ds))
+		}
+		d := ds[0]
+		if d.GetMessage() != tt.want || d.GetSeverity() != rdf.Severity_INFO || d.GetLocation() != nil {
+			t.Errorf("%q: got %v, want INFO %q without location", tt.input, d, tt.want)
+		}
+	}
+}
+
+func TestProcessor_Stats(t *testing.T) {
+	const sample = `{"message":"error","severity":"ERROR","location":{"path":"a.go"}}
+{"message":"warning","severity":"WARNING","location":{"path":"a.go"}}
+{"message":"info","severity":"INFO","location":{"path":"a.go"}}`
+	var stats ParseStats
+	p, err := New(&Option{FormatName: "rdjsonl", DropSeverities: []string{"info"}, Stats: &stats})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Parse(strings.NewReader(sample)); err != nil {
+		t.Fatal(err)
+	}
+	want := ParseStats{
+		Lines:         3,
+		Total:         3,
+		Valid:         2,
+		Skipped:       1,
+		BySeverity:    map[rdf.Severity]int{rdf.Severity_ERROR: 1, rdf.Severity_WARNING: 1},
+		ParseWarnings: []string{"1 diagnostics skipped by post-processing"},
+	}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("stats (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_Stats_explodeMultiLineRange(t *testing.T) {
+	const sample = `{"message":"multi","severity":"WARNING","location":{"path":"a.go","range":{"start":{"line":1},"end":{"line":3}}}}
+{"message":"info","severity":"INFO","location":{"path":"a.go","range":{"start":{"line":5}}}}`
+	var stats ParseStats
+	p, err := New(&Option{FormatName: "rdjsonl", ExplodeMultiLineRange: true, DropSeverities: []string{"info"}, Stats: &stats})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Parse(strings.NewReader(sample)); err != nil {
+		t.Fatal(err)
+	}
+	want := ParseStats{
+		Lines:         2,
+		Total:         2,
+		Valid:         3,
+		Skipped:       1,
+		BySeverity:    map[rdf.Severity]int{rdf.Severity_WARNING: 3},
+		ParseWarnings: []string{"1 diagnostics skipped by post-processing"},
+	}
+	if diff := cmp.Diff(want, stats); diff != "" {
+		t.Errorf("stats (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_Reverse(t *testing.T) {
+	const sample = `{"message":"old:1","location":{"path":"old.go","range":{"start":{"line":1}}}}
+{"message":"new:2","location":{"path":"new.go","range":{"start":{"line":2}}}}
+{"message":"new:1","location":{"path":"new.go","range":{"start":{"line":1}}}}`
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fr := fakeStatFileReader{mtimes: map[string]time.Time{"old.go": now.Add(-time.Hour), "new.go": now}}
+	tests := []struct {
+		opt  *Option
+		want []string
+	}{
+		{
+			opt:  &Option{FormatName: "rdjsonl", Reverse: true},
+			want: []string{"new:1", "new:2", "old:1"},
+		},
+		{
+			opt:  &Option{FormatName: "rdjsonl", Reverse: true, SortByFileMtime: true, FileReader: fr},
+			want: []string{"old:1", "new:2", "new:1"},
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			got = append(got, d.GetMessage())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("SortByFileMtime=%v: order (-want +got):\n%s", tt.opt.SortByFileMtime, diff)
+		}
+	}
+}
+
+func TestProcessor_DiffPositions(t *testing.T) {
+	const sample = `{"message":"in hunk","location":{"path":"a.go","range":{"start":{"line":11}}}}
+{"message":"out of hunk","location":{"path":"a.go","range":{"start":{"line":30}}}}
+{"message":"other file","location":{"path":"b.go","range":{"start":{"line":2}}}}
+{"message":"file-level","location":{"path":"a.go"}}`
+	p, err := New(&Option{
+		FormatName: "rdjsonl",
+		DiffPositions: map[string]map[int]int{
+			"a.go": {10: 1, 11: 2, 12: 4},
+			"b.go": {2: 7},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		pos     int32
+		flagged bool
+	}{
+		{pos: 2},
+		{flagged: true},
+		{pos: 7},
+		{flagged: true},
+	}
+	for i, tt := range tests {
+		d := ds[i]
+		if got := d.GetDiffPosition(); got != tt.pos {
+			t.Errorf("%s: got diff position %d, want %d", d.GetMessage(), got, tt.pos)
+		}
+		if got := d.GetMetadata()[NoDiffPositionMetadataKey] == "true"; got != tt.flagged {
+			t.Errorf("%s: got flagged %v, want %v", d.GetMessage(), got, tt.flagged)
+		}
+	}
+}
+
+func TestProcessor_InlineRelatedLocations(t *testing.T) {
+	const sample = `{"message":"possible nil dereference","location":{"path":"a.go","range":{"start":{"line":20}}},"related_locations":[{"message":"assigned nil here","location":{"path":"a.go","range":{"start":{"line":10}}}},{"location":{"path":"b.go","range":{"start":{"line":3}}}}]}
+{"message":"no related locations","location":{"path":"a.go"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", InlineRelatedLocations: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Diagnostic{
+		{
+			Message:  "possible nil dereference\n- assigned nil here (a.go:10)\n- b.go:3",
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 20}}},
+		},
+		{
+			Message:  "no related locations",
+			Location: &rdf.Location{Path: "a.go"},
+		},
+	}
+	if diff := cmp.Diff(want, ds, protocmp.Transform(),
+		protocmp.IgnoreFields(&rdf.Diagnostic{}, "original_output", "format")); diff != "" {
+		t.Errorf("diagnostics (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_Category(t *testing.T) {
+	const sample = `{"message":"msg1","source":{"name":"gosec"},"location":{"path":"a.go"}}
+{"message":"msg2","source":{"name":"gosec"},"location":{"path":"b.go"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", Category: "security"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(ds))
+	}
+	for _, d := range ds {
+		if got := d.GetCategory(); got != "security" {
+			t.Errorf("%s: got category %q, want %q", d.GetMessage(), got, "security")
+		}
+		if got := d.GetSource().GetName(); got != "gosec" {
+			t.Errorf("%s: got source %q, want %q", d.GetMessage(), got, "gosec")
+		}
+	}
+}
+
+func TestProcessor_ParseWarnings(t *testing.T) {
+	tests := []struct {
+		opt    *Option
+		sample string
+		want   []string
+	}{
+		{
+			opt: &Option{FormatName: "golint"},
+			sample: `main.go:1:1: exported function Foo should have comment or be unexported
+ok  	github.com/reviewdog/reviewdog	0.1s
+main.go:2:1: don't use underscores in Go names
+FAIL
+exit status 1
+`,
+			want: []string{"3 of 5 lines skipped"},
+		},
+		{
+			opt: &Option{FormatName: "rdjsonl", DropSeverities: []string{"info"}},
+			sample: `{"message":"run-level error","severity":"ERROR"}
+{"message":"no path","severity":"WARNING","location":{}}
+{"message":"dropped","severity":"INFO"}
+{"message":"ok","severity":"WARNING","location":{"path":"a.go"}}`,
+			want: []string{"2 diagnostics had no location", "1 diagnostics skipped by post-processing"},
+		},
+		{
+			opt:    &Option{FormatName: "rdjsonl"},
+			sample: `{"message":"ok","location":{"path":"a.go"}}`,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		var stats ParseStats
+		tt.opt.Stats = &stats
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.Parse(strings.NewReader(tt.sample)); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tt.want, stats.ParseWarnings); diff != "" {
+			t.Errorf("%s: parse warnings (-want +got):\n%s", tt.opt.FormatName, diff)
+		}
+	}
+}
+
+func TestProcessor_MaxResults(t *testing.T) {
+	const sample = `{"message":"info1","severity":"INFO","location":{"path":"a.go"}}
+{"message":"error1","severity":"ERROR","location":{"path":"a.go"}}
+{"message":"warning1","severity":"WARNING","location":{"path":"a.go"}}
+{"message":"info2","severity":"INFO","location":{"path":"a.go"}}
+{"message":"error2","severity":"ERROR","location":{"path":"a.go"}}
+{"message":"warning2","severity":"WARNING","location":{"path":"a.go"}}`
+	tests := []struct {
+		opt  *Option
+		want []string
+	}{
+		{
+			opt:  &Option{FormatName: "rdjsonl", MaxResults: 10},
+			want: []string{"info1", "error1", "warning1", "info2", "error2", "warning2"},
+		},
+		{
+			opt:  &Option{FormatName: "rdjsonl", MaxResults: 4},
+			want: []string{"error1", "warning1", "error2", "warning2"},
+		},
+		{
+			opt:  &Option{FormatName: "rdjsonl", MaxResults: 3},
+			want: []string{"error1", "warning1", "error2"},
+		},
+		{
+			opt:  &Option{FormatName: "rdjsonl", MaxResults: 1},
+			want: []string{"error1"},
+		},
+		{
+			// Keep infos over warnings.
+			opt:  &Option{FormatName: "rdjsonl", MaxResults: 4, DropOrder: []rdf.Severity{rdf.Severity_WARNING}},
+			want: []string{"info1", "error1", "info2", "error2"},
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			got = append(got, d.GetMessage())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("MaxResults=%d, DropOrder=%v: messages (-want +got):\n%s", tt.opt.MaxResults, tt.opt.DropOrder, diff)
+		}
+	}
+}
+
+func TestProcessor_DedupSuggestionsGlobally(t *testing.T) {
+	const sample = `{"message":"gofmt","location":{"path":"a.go","range":{"start":{"line":3}}},"suggestions":[{"range":{"start":{"line":3,"column":1},"end":{"line":3,"column":10}},"text":"x := 1"}]}
+{"message":"goimports","location":{"path":"a.go","range":{"start":{"line":3}}},"suggestions":[{"range":{"start":{"line":3,"column":1},"end":{"line":3,"column":10}},"text":"x := 1"},{"range":{"start":{"line":5,"column":1},"end":{"line":5,"column":3}},"text":"y"}]}
+{"message":"other file","location":{"path":"b.go","range":{"start":{"line":3}}},"suggestions":[{"range":{"start":{"line":3,"column":1},"end":{"line":3,"column":10}},"text":"x := 1"}]}`
+	tests := []struct {
+		dedup bool
+		want  []int
+	}{
+		{dedup: false, want: []int{1, 2, 1}},
+		{dedup: true, want: []int{1, 1, 1}},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "rdjsonl", DedupSuggestionsGlobally: tt.dedup})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int
+		for _, d := range ds {
+			got = append(got, len(d.GetSuggestions()))
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("DedupSuggestionsGlobally=%v: number of suggestions (-want +got):\n%s", tt.dedup, diff)
+		}
+		if tt.dedup {
+			if got := ds[1].GetSuggestions()[0].GetText(); got != "y" {
+				t.Errorf("got remaining suggestion %q, want %q", got, "y")
+			}
+		}
+	}
+}
+
+func TestProcessor_MessageSuffix(t *testing.T) {
+	const sample = `{"message":"msg","severity":"ERROR","location":{"path":"a.go"}}`
+	seen := make(map[string]bool)
+	p, err := New(&Option{
+		FormatName:       "rdjsonl",
+		SeverityEmoji:    true,
+		MessageSuffix:    "See CONTRIBUTING.md for how to fix.",
+		SeenFingerprints: seen,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ds[0].GetMessage(), "❌ msg\nSee CONTRIBUTING.md for how to fix."; got != want {
+		t.Errorf("got message %q, want %q", got, want)
+	}
+	d := &rdf.Diagnostic{Message: "msg", Severity: rdf.Severity_ERROR, Location: &rdf.Location{Path: "a.go"}}
+	if !seen[DiagnosticFingerprint(d, nil)] {
+		t.Error("fingerprint should be computed without the suffix")
+	}
+}
+
+func TestProcessor_ExplodeMultiLineRange(t *testing.T) {
+	const sample = `{"message":"three lines","location":{"path":"a.go","range":{"start":{"line":10,"column":5},"end":{"line":12,"column":3}}},"suggestions":[{"text":"fixed"}]}
+{"message":"single line","location":{"path":"a.go","range":{"start":{"line":20,"column":1},"end":{"line":20,"column":4}}}}
+{"message":"huge","location":{"path":"a.go","range":{"start":{"line":1},"end":{"line":1000}}}}`
+	p, err := New(&Option{FormatName: "rdjsonl", ExplodeMultiLineRange: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Diagnostic{
+		{
+			Message:     "three lines",
+			Location:    &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 10, Column: 5}}},
+			Suggestions: []*rdf.Suggestion{{Text: "fixed"}},
+		},
+		{
+			Message:  "three lines",
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 11}}},
+		},
+		{
+			Message:  "three lines",
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 12}, End: &rdf.Position{Line: 12, Column: 3}}},
+		},
+		{
+			Message:  "single line",
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 20, Column: 1}, End: &rdf.Position{Line: 20, Column: 4}}},
+		},
+		{
+			Message:  "huge",
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 1}, End: &rdf.Position{Line: 1000}}},
+		},
+	}
+	if diff := cmp.Diff(want, ds, protocmp.Transform(),
+		protocmp.IgnoreFields(&rdf.Diagnostic{}, "original_output", "format")); diff != "" {
+		t.Errorf("diagnostics (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_IndexResults(t *testing.T) {
+	const sample = `{"message":"c","severity":"INFO","location":{"path":"c.go"}}
+{"message":"a","severity":"ERROR","location":{"path":"a.go"}}
+{"message":"b","severity":"WARNING","location":{"path":"b.go"}}`
+	p, err := New(&Option{FormatName: "rdjsonl", IndexResults: true, DropSeverities: []string{"warning"}, Reverse: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]int32)
+	for _, d := range ds {
+		got[d.GetMessage()] = d.GetIndex()
+	}
+	want := map[string]int32{"c": 0, "a": 1}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("indices (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_MaxRelatedMessageBytes(t *testing.T) {
+	const sample = `{"message":"msg","location":{"path":"a.go"},"related_locations":[{"message":"12345","location":{"path":"a.go","range":{"start":{"line":1}}}},{"message":"67890","location":{"path":"a.go","range":{"start":{"line":2}}}},{"message":"x","location":{"path":"a.go","range":{"start":{"line":3}}}}]}`
+	tests := []struct {
+		opt         *Option
+		wantMessage string
+		wantRelated int
+	}{
+		{
+			opt:         &Option{FormatName: "rdjsonl", MaxRelatedMessageBytes: 11},
+			wantMessage: "msg",
+			wantRelated: 3,
+		},
+		{
+			opt:         &Option{FormatName: "rdjsonl", MaxRelatedMessageBytes: 8},
+			wantMessage: "msg",
+			wantRelated: 1,
+		},
+		{
+			opt:         &Option{FormatName: "rdjsonl", MaxRelatedMessageBytes: 8, InlineRelatedLocations: true},
+			wantMessage: "msg\n- 12345 (a.go:1)",
+			wantRelated: 0,
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ds[0].GetMessage(); got != tt.wantMessage {
+			t.Errorf("MaxRelatedMessageBytes=%d: got message %q, want %q", tt.opt.MaxRelatedMessageBytes, got, tt.wantMessage)
+		}
+		if got := len(ds[0].GetRelatedLocations()); got != tt.wantRelated {
+			t.Errorf("MaxRelatedMessageBytes=%d: got %d related locations, want %d", tt.opt.MaxRelatedMessageBytes, got, tt.wantRelated)
+		}
+	}
+}
+
+func TestProcessor_CountDuplicates(t *testing.T) {
+	const sample = `{"message":"trailing whitespace","location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"trailing whitespace","location":{"path":"a.go","range":{"start":{"line":5}}}}
+{"message":"missing newline","location":{"path":"a.go"}}
+{"message":"trailing whitespace","location":{"path":"b.go","range":{"start":{"line":2}}}}
+{"message":"trailing whitespace","location":{"path":"a.go","range":{"start":{"line":9}}}}`
+	p, err := New(&Option{FormatName: "rdjsonl", CountDuplicates: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, fmt.Sprintf("%s:%d: %s (%d)", d.GetLocation().GetPath(), d.GetLocation().GetRange().GetStart().GetLine(), d.GetMessage(), d.GetOccurrenceCount()))
+	}
+	want := []string{
+		"a.go:1: trailing whitespace (3 occurrences) (3)",
+		"a.go:0: missing newline (0)",
+		"b.go:2: trailing whitespace (0)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("diagnostics (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_MinConfidence(t *testing.T) {
+	const sample = `{"Issues":[
+{"severity":"HIGH","confidence":"HIGH","rule_id":"G101","details":"high","file":"a.go","line":"1","column":"1"},
+{"severity":"HIGH","confidence":"MEDIUM","rule_id":"G102","details":"medium","file":"a.go","line":"2","column":"1"},
+{"severity":"HIGH","confidence":"LOW","rule_id":"G103","details":"low","file":"a.go","line":"3","column":"1"}
+]}`
+	tests := []struct {
+		min  string
+		want []string
+	}{
+		{min: "", want: []string{"high", "medium", "low"}},
+		{min: "medium", want: []string{"high", "medium"}},
+		{min: "HIGH", want: []string{"high"}},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "gosec-json", MinConfidence: tt.min})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			got = append(got, d.GetMessage())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("MinConfidence=%q: messages (-want +got):\n%s", tt.min, diff)
+		}
+	}
+	if _, err := New(&Option{FormatName: "gosec-json", MinConfidence: "certain"}); err == nil {
+		t.Error("got no error for invalid MinConfidence")
+	}
+}
+
+func TestProcessor_SeverityRemap(t *testing.T) {
+	const sample = `{"message":"info","severity":"INFO","location":{"path":"a.go","range":{"start":{"line":1}}}}
+{"message":"warning","severity":"WARNING","location":{"path":"a.go","range":{"start":{"line":2}}}}
+{"message":"critical","severity":"ERROR","location":{"path":"a.go","range":{"start":{"line":3}}}}`
+	p, err := New(&Option{
+		FormatName:     "rdjsonl",
+		SeverityRemap:  map[rdf.Severity]rdf.Severity{rdf.Severity_INFO: rdf.Severity_WARNING},
+		DropSeverities: []string{"warning"},
+		FailOnSeverity: rdf.Severity_WARNING,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if !errors.Is(err, ErrThresholdExceeded) {
+		t.Errorf("got error %v, want ErrThresholdExceeded", err)
+	}
+	// The original WARNING is dropped before remapping, and remapped INFO is
+	// kept as WARNING with the original label.
+	want := []*rdf.Diagnostic{
+		{
+			Message:  "info",
+			Severity: rdf.Severity_WARNING,
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 1}}},
+			Metadata: map[string]string{OriginalSeverityMetadataKey: "INFO"},
+		},
+		{
+			Message:  "critical",
+			Severity: rdf.Severity_ERROR,
+			Location: &rdf.Location{Path: "a.go", Range: &rdf.Range{Start: &rdf.Position{Line: 3}}},
+		},
+	}
+	if diff := cmp.Diff(want, ds, protocmp.Transform(), protocmp.IgnoreFields(&rdf.Diagnostic{}, "original_output", "format")); diff != "" {
+		t.Errorf("diagnostics (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_GroupAndSort(t *testing.T) {
+	const sample = `{"message":"z1","location":{"path":"z.go","range":{"start":{"line":5}}}}
+{"message":"a1","location":{"path":"a.go","range":{"start":{"line":3,"column":9}}}}
+{"message":"z2","location":{"path":"z.go","range":{"start":{"line":1}}}}
+{"message":"a2","location":{"path":"a.go","range":{"start":{"line":3,"column":2}}}}
+{"message":"m1","location":{"path":"m.go","range":{"start":{"line":2}}}}
+{"message":"a3","location":{"path":"a.go","range":{"start":{"line":1}}}}`
+	p, err := New(&Option{FormatName: "rdjsonl", GroupAndSort: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetMessage())
+	}
+	want := []string{"z2", "z1", "a3", "a2", "a1", "m1"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("messages (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessor_StopLine(t *testing.T) {
+	const sample = "main.go:1:1\terrcheck\tbefore stop\n" +
+		"===REVIEWDOG-END===\r\n" +
+		"main.go:2:1\terrcheck\tafter stop\n" +
+		"{\"unrelated\": \"data\"}\n"
+	for _, opt := range []*Option{
+		{FormatName: "golangci-lint-tab", StopLine: "===REVIEWDOG-END==="},
+		{Errorformat: []string{"%f:%l:%c\t%*[^\t]\t%m"}, StopLine: "===REVIEWDOG-END==="},
+	} {
+		p, err := New(opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(iotest.HalfReader(strings.NewReader(sample)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			got = append(got, d.GetMessage())
+		}
+		if diff := cmp.Diff([]string{"before stop"}, got); diff != "" {
+			t.Errorf("format %q: messages (-want +got):\n%s", opt.FormatName, diff)
+		}
+	}
+}
+
+func TestProcessor_CorrelationID(t *testing.T) {
+	const sample = `{"message":"msg1","location":{"path":"a.go"}}
+{"message":"msg2","location":{"path":"b.go"},"metadata":{"correlation_id":"reported"}}
+{"message":"msg3"}`
+	parse := func(opt *Option) []string {
+		t.Helper()
+		p, err := New(opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, d := range ds {
+			ids = append(ids, d.GetMetadata()[CorrelationIDMetadataKey])
+		}
+		return ids
+	}
+
+	got := parse(&Option{FormatName: "rdjsonl", CorrelationID: "run-42", GenerateCorrelationID: true})
+	if diff := cmp.Diff([]string{"run-42", "run-42", "run-42"}, got); diff != "" {
+		t.Errorf("CorrelationID: ids (-want +got):\n%s", diff)
+	}
+
+	opt := &Option{FormatName: "rdjsonl", GenerateCorrelationID: true}
+	first := parse(opt)
+	for _, id := range first {
+		if id == "" || id != first[0] {
+			t.Fatalf("generated ids are not stable within a parse: %q", first)
+		}
+	}
+	if second := parse(opt); second[0] == first[0] {
+		t.Errorf("got the same generated id %q for different parses", first[0])
+	}
+}
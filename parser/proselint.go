@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &ProselintParser{}
+
+// ProselintParser is parser for proselint JSON output (proselint --json).
+// proselint doesn't report the checked file, so diagnostics are reported on
+// Option.Path.
+// https://github.com/amperser/proselint
+type ProselintParser struct {
+	path string
+}
+
+// NewProselintParser returns a new ProselintParser which reports diagnostics
+// on path.
+func NewProselintParser(path string) *ProselintParser {
+	return &ProselintParser{path: path}
+}
+
+// Parse parses proselint JSON output.
+func (p *ProselintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var result ProselintResult
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode proselint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, e := range result.Data.Errors {
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: p.path,
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(e.Line), Column: int32(e.Column)},
+				},
+			},
+			Message:        e.Message,
+			Severity:       ParseSeverity(e.Severity),
+			Format:         "proselint",
+			OriginalOutput: fmt.Sprintf("%s:%d:%d: %s %s", p.path, e.Line, e.Column, e.Check, e.Message),
+		}
+		if e.Check != "" {
+			d.Code = &rdf.Code{Value: e.Check}
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// ProselintResult represents proselint JSON output.
+// {"status":"success","data":{"errors":[{"check":"typography.symbols.ellipsis","message":"msg","line":1,"column":5,"start":4,"end":7,"extent":3,"severity":"warning","replacements":"…"}]}}
+type ProselintResult struct {
+	Data struct {
+		Errors []*ProselintError `json:"errors"`
+	} `json:"data"`
+}
+
+// ProselintError represents an error reported by proselint. Line and column
+// are 1-based, and start and end are 0-based offsets.
+type ProselintError struct {
+	Check    string `json:"check"`
+	Message  string `json:"message"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	Severity string `json:"severity"`
+}
@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleProselintParser() {
+	// proselint --json README.md
+	const sample = `{
+  "status": "success",
+  "data": {
+    "errors": [
+      {
+        "check": "typography.symbols.ellipsis",
+        "column": 15,
+        "end": 17,
+        "extent": 3,
+        "line": 3,
+        "message": "'...' is an approximation, use the ellipsis symbol '…'.",
+        "replacements": "…",
+        "severity": "warning",
+        "start": 14
+      },
+      {
+        "check": "leonard.exclamation.multiple",
+        "column": 1,
+        "end": 120,
+        "extent": 2,
+        "line": 8,
+        "message": "Stop yelling. Keep your exclamation points under control.",
+        "replacements": null,
+        "severity": "error",
+        "start": 118
+      }
+    ]
+  }
+}`
+
+	p := NewProselintParser("README.md")
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "'...' is an approximation, use the ellipsis symbol '…'.",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 15
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "typography.symbols.ellipsis"
+	//   },
+	//   "originalOutput": "README.md:3:15: typography.symbols.ellipsis '...' is an approximation, use the ellipsis symbol '…'.",
+	//   "format": "proselint"
+	// }
+	// {
+	//   "message": "Stop yelling. Keep your exclamation points under control.",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 8,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "leonard.exclamation.multiple"
+	//   },
+	//   "originalOutput": "README.md:8:1: leonard.exclamation.multiple Stop yelling. Keep your exclamation points under control.",
+	//   "format": "proselint"
+	// }
+}
@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &PyrightParser{}
+
+// PyrightParser is parser for pyright JSON output (pyright --outputjson).
+// https://github.com/microsoft/pyright
+type PyrightParser struct {
+	preserveRaw bool
+}
+
+// NewPyrightParser returns a new PyrightParser.
+func NewPyrightParser() *PyrightParser {
+	return &PyrightParser{}
+}
+
+// Parse parses pyright JSON output.
+func (p *PyrightParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var report PyrightReport
+	if err := json.NewDecoder(r).Decode(&report); err != nil {
+		return nil, fmt.Errorf("failed to decode pyright JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, diag := range report.GeneralDiagnostics {
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path:  diag.File,
+				Range: diag.Range.rdfRange(),
+			},
+			Message:        diag.Message,
+			Severity:       ParseSeverity(diag.Severity),
+			Format:         "pyright",
+			OriginalOutput: fmt.Sprintf("%s - %s: %s", diag.File, diag.Severity, diag.Message),
+		}
+		if diag.Range != nil {
+			d.OriginalOutput = fmt.Sprintf("%s:%d:%d - %s: %s", diag.File,
+				diag.Range.Start.Line+1, diag.Range.Start.Character+1, diag.Severity, diag.Message)
+		}
+		if diag.Rule != "" {
+			d.Code = &rdf.Code{Value: diag.Rule}
+		}
+		if p.preserveRaw {
+			d.Raw = diag.raw
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// PyrightReport represents pyright JSON output.
+// {"generalDiagnostics":[{"file":"/path/to/a.py","severity":"error","message":"msg","rule":"reportGeneralTypeIssues","range":{"start":{"line":0,"character":4},"end":{"line":0,"character":7}}}]}
+type PyrightReport struct {
+	GeneralDiagnostics []*PyrightDiagnostic `json:"generalDiagnostics"`
+}
+
+// PyrightDiagnostic represents a diagnostic of pyright.
+type PyrightDiagnostic struct {
+	File     string        `json:"file"`
+	Severity string        `json:"severity"`
+	Message  string        `json:"message"`
+	Rule     string        `json:"rule"`
+	Range    *PyrightRange `json:"range"` // nil for e.g. config errors.
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler to keep the raw JSON.
+func (d *PyrightDiagnostic) UnmarshalJSON(b []byte) error {
+	type diagnostic PyrightDiagnostic // Avoid recursion.
+	return unmarshalRaw(b, (*diagnostic)(d), &d.raw)
+}
+
+// PyrightRange represents a range. End is exclusive.
+type PyrightRange struct {
+	Start PyrightPosition `json:"start"`
+	End   PyrightPosition `json:"end"`
+}
+
+// PyrightPosition represents a position. Both line and character are
+// 0-based.
+type PyrightPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+func (r *PyrightRange) rdfRange() *rdf.Range {
+	if r == nil {
+		return nil
+	}
+	return &rdf.Range{
+		Start: r.Start.rdfPosition(),
+		End:   r.End.rdfPosition(),
+	}
+}
+
+func (p PyrightPosition) rdfPosition() *rdf.Position {
+	return &rdf.Position{Line: int32(p.Line + 1), Column: int32(p.Character + 1)}
+}
@@ -0,0 +1,161 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExamplePyrightParser() {
+	// pyright --outputjson
+	const sample = `{
+  "version": "1.1.85",
+  "time": "1605833640401",
+  "generalDiagnostics": [
+    {
+      "file": "/home/user/project/main.py",
+      "severity": "error",
+      "message": "Import \"foo\" could not be resolved",
+      "range": {
+        "start": {"line": 0, "character": 7},
+        "end": {"line": 0, "character": 10}
+      },
+      "rule": "reportMissingImports"
+    },
+    {
+      "file": "/home/user/project/main.py",
+      "severity": "warning",
+      "message": "\"x\" is possibly unbound",
+      "range": {
+        "start": {"line": 9, "character": 4},
+        "end": {"line": 9, "character": 5}
+      },
+      "rule": "reportUnboundVariable"
+    },
+    {
+      "file": "/home/user/project/util.py",
+      "severity": "information",
+      "message": "Code is unreachable",
+      "range": {
+        "start": {"line": 3, "character": 0},
+        "end": {"line": 4, "character": 0}
+      }
+    }
+  ],
+  "summary": {"filesAnalyzed": 2, "errorCount": 1, "warningCount": 1, "informationCount": 1, "timeInSec": 0.5}
+}`
+
+	p := NewPyrightParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Import \"foo\" could not be resolved",
+	//   "location": {
+	//     "path": "/home/user/project/main.py",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 8
+	//       },
+	//       "end": {
+	//         "line": 1,
+	//         "column": 11
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "reportMissingImports"
+	//   },
+	//   "originalOutput": "/home/user/project/main.py:1:8 - error: Import \"foo\" could not be resolved",
+	//   "format": "pyright"
+	// }
+	// {
+	//   "message": "\"x\" is possibly unbound",
+	//   "location": {
+	//     "path": "/home/user/project/main.py",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 5
+	//       },
+	//       "end": {
+	//         "line": 10,
+	//         "column": 6
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "reportUnboundVariable"
+	//   },
+	//   "originalOutput": "/home/user/project/main.py:10:5 - warning: \"x\" is possibly unbound",
+	//   "format": "pyright"
+	// }
+	// {
+	//   "message": "Code is unreachable",
+	//   "location": {
+	//     "path": "/home/user/project/util.py",
+	//     "range": {
+	//       "start": {
+	//         "line": 4,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 5,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "originalOutput": "/home/user/project/util.py:4:1 - information: Code is unreachable",
+	//   "format": "pyright"
+	// }
+}
+
+func TestPyrightParser_position(t *testing.T) {
+	// The first character of the file.
+	const sample = `{"generalDiagnostics":[{"file":"a.py","severity":"error","message":"msg","range":{"start":{"line":0,"character":0},"end":{"line":0,"character":1}}}]}`
+	ds, err := NewPyrightParser().Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng := ds[0].GetLocation().GetRange()
+	if rng.GetStart().GetLine() != 1 || rng.GetStart().GetColumn() != 1 {
+		t.Errorf("start: got %v, want line 1, column 1", rng.GetStart())
+	}
+	if rng.GetEnd().GetLine() != 1 || rng.GetEnd().GetColumn() != 2 {
+		t.Errorf("end: got %v, want line 1, column 2", rng.GetEnd())
+	}
+}
+
+func TestPyrightParser_noRange(t *testing.T) {
+	// e.g. a config error reported without range.
+	const sample = `{"generalDiagnostics":[{"file":"/path/to/pyrightconfig.json","severity":"error","message":"Config \"venvPath\" field is not a valid path"}]}`
+	ds, err := NewPyrightParser().Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 1 {
+		t.Fatalf("got %d diagnostics, want 1", len(ds))
+	}
+	if rng := ds[0].GetLocation().GetRange(); rng != nil {
+		t.Errorf("got range %v, want nil", rng)
+	}
+	if got, want := ds[0].GetOriginalOutput(), `/path/to/pyrightconfig.json - error: Config "venvPath" field is not a valid path`; got != want {
+		t.Errorf("got original output %q, want %q", got, want)
+	}
+}
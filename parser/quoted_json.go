@@ -0,0 +1,51 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &QuotedJSONParser{}
+
+// QuotedJSONParser is Parser which extracts tool output quoted in a string
+// field of a JSON object and parses it with the inner Parser, e.g. rdjson
+// accidentally wrapped by CI steps.
+//
+//	{"output":"{\"message\":\"msg\",\"location\":{\"path\":\"a.go\"}}\n"}
+type QuotedJSONParser struct {
+	inner Parser
+	field string
+}
+
+// NewQuotedJSONParser returns a new QuotedJSONParser which extracts the
+// named string field and parses its contents with inner.
+func NewQuotedJSONParser(inner Parser, field string) *QuotedJSONParser {
+	return &QuotedJSONParser{inner: inner, field: field}
+}
+
+// Parse parses the quoted output. Input which isn't a JSON object with the
+// field is tolerated and passed to the inner Parser as is.
+func (p *QuotedJSONParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return p.inner.Parse(bytes.NewReader(b))
+	}
+	raw, ok := obj[p.field]
+	if !ok {
+		return p.inner.Parse(bytes.NewReader(b))
+	}
+	var quoted string
+	if err := json.Unmarshal(raw, &quoted); err != nil {
+		return nil, fmt.Errorf("failed to decode quoted JSON field %q: %w", p.field, err)
+	}
+	return p.inner.Parse(bytes.NewReader([]byte(quoted)))
+}
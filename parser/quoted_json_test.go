@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func TestQuotedJSONParser(t *testing.T) {
+	const rdjsonl = `{"message":"error return value not checked","location":{"path":"main.go","range":{"start":{"line":15,"column":9}}},"severity":"ERROR","code":{"value":"errcheck"}}
+{"message":"ineffectual assignment","location":{"path":"main.go","range":{"start":{"line":12,"column":2}}},"severity":"WARNING","code":{"value":"ineffassign"}}
+`
+	quoted, err := json.Marshal(rdjsonl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*rdf.Diagnostic{
+		{
+			Message:  "error return value not checked",
+			Location: &rdf.Location{Path: "main.go", Range: &rdf.Range{Start: &rdf.Position{Line: 15, Column: 9}}},
+			Severity: rdf.Severity_ERROR,
+			Code:     &rdf.Code{Value: "errcheck"},
+		},
+		{
+			Message:  "ineffectual assignment",
+			Location: &rdf.Location{Path: "main.go", Range: &rdf.Range{Start: &rdf.Position{Line: 12, Column: 2}}},
+			Severity: rdf.Severity_WARNING,
+			Code:     &rdf.Code{Value: "ineffassign"},
+		},
+	}
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "quoted", input: `{"step":"lint","output":` + string(quoted) + `}`},
+		{name: "not quoted", input: rdjsonl},
+	}
+	for _, tt := range tests {
+		p := NewQuotedJSONParser(NewRDJSONLParser(), "output")
+		ds, err := p.Parse(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if diff := cmp.Diff(want, ds, protocmp.Transform(),
+			protocmp.IgnoreFields(&rdf.Diagnostic{}, "original_output", "format")); diff != "" {
+			t.Errorf("%s: diff (-want +got):\n%s", tt.name, diff)
+		}
+	}
+
+	p := NewQuotedJSONParser(NewRDJSONLParser(), "output")
+	if _, err := p.Parse(strings.NewReader(`{"output":42}`)); err == nil {
+		t.Error("want error for non-string field")
+	}
+}
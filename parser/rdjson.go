@@ -26,6 +26,11 @@ func (p *RDJSONParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 	if err != nil {
 		return nil, err
 	}
+	return parseRDJSON(b, "rdjson")
+}
+
+// parseRDJSON parses rdjson. Format of diagnostics defaults to format.
+func parseRDJSON(b []byte, format string) ([]*rdf.Diagnostic, error) {
 	var dr rdf.DiagnosticResult
 	if err := protojson.Unmarshal(b, &dr); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal rdjson (DiagnosticResult): %w", err)
@@ -42,6 +47,9 @@ func (p *RDJSONParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 			// TODO(haya14busa): Refactor not to fill in original output.
 			d.OriginalOutput = d.String()
 		}
+		if d.Format == "" {
+			d.Format = format
+		}
 	}
 	return dr.Diagnostics, nil
 }
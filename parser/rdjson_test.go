@@ -106,7 +106,8 @@ func ExampleRDJSONParser() {
 	//   "severity": "INFO",
 	//   "source": {
 	//     "name": "deadcode"
-	//   }
+	//   },
+	//   "format": "rdjson"
 	// }
 	// {
 	//   "message": "printf: Sprintf format %d reads arg #1, but call has 0 args",
@@ -123,7 +124,8 @@ func ExampleRDJSONParser() {
 	//   "source": {
 	//     "name": "linter-name",
 	//     "url": "https://github.com/reviewdog#linter-name"
-	//   }
+	//   },
+	//   "format": "rdjson"
 	// }
 	// {
 	//   "message": "severity test (string)",
@@ -139,7 +141,8 @@ func ExampleRDJSONParser() {
 	//   "severity": "WARNING",
 	//   "source": {
 	//     "name": "severity-test"
-	//   }
+	//   },
+	//   "format": "rdjson"
 	// }
 	// {
 	//   "message": "severity test (number)",
@@ -155,6 +158,7 @@ func ExampleRDJSONParser() {
 	//   "severity": "ERROR",
 	//   "source": {
 	//     "name": "severity-test"
-	//   }
+	//   },
+	//   "format": "rdjson"
 	// }
 }
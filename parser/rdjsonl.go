@@ -10,7 +10,7 @@ import (
 	"github.com/reviewdog/reviewdog/proto/rdf"
 )
 
-var _ Parser = &RDJSONLParser{}
+var _ StreamParser = &RDJSONLParser{}
 
 // RDJSONLParser is parser for rdjsonl format.
 type RDJSONLParser struct{}
@@ -25,15 +25,63 @@ func (p *RDJSONLParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
 	var results []*rdf.Diagnostic
 	s := bufio.NewScanner(r)
 	for s.Scan() {
-		d := new(rdf.Diagnostic)
-		if err := protojson.Unmarshal(s.Bytes(), d); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal rdjsonl (Diagnostic): %w", err)
-		}
-		if d.GetOriginalOutput() == "" {
-			// TODO(haya14busa): Refactor not to fill in original output.
-			d.OriginalOutput = s.Text()
+		d, err := parseRDJSONLLine(s.Text())
+		if err != nil {
+			return nil, err
 		}
 		results = append(results, d)
 	}
 	return results, nil
 }
+
+// ParseStream parses rdjsonl and sends each diagnostic to ch as soon as its
+// line is read.
+func (p *RDJSONLParser) ParseStream(r io.Reader, ch chan<- *rdf.Diagnostic) error {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		d, err := parseRDJSONLLine(s.Text())
+		if err != nil {
+			return err
+		}
+		ch <- d
+	}
+	return s.Err()
+}
+
+func parseRDJSONLLine(line string) (*rdf.Diagnostic, error) {
+	d := new(rdf.Diagnostic)
+	if err := protojson.Unmarshal([]byte(line), d); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rdjsonl (Diagnostic): %w", err)
+	}
+	if d.GetOriginalOutput() == "" {
+		// TODO(haya14busa): Refactor not to fill in original output.
+		d.OriginalOutput = line
+	}
+	if d.Format == "" {
+		d.Format = "rdjsonl"
+	}
+	return d, nil
+}
+
+// StreamWriteRDJSONL writes diagnostics received from ch as rdjsonl until ch
+// is closed. Each line is flushed if w has Flush method (e.g. bufio.Writer).
+// It returns on the first write error without draining ch, so producers
+// should stop sending on error.
+func StreamWriteRDJSONL(w io.Writer, ch <-chan *rdf.Diagnostic) error {
+	f, _ := w.(interface{ Flush() error })
+	for d := range ch {
+		b, err := protojson.Marshal(d)
+		if err != nil {
+			return fmt.Errorf("failed to marshal rdjsonl (Diagnostic): %w", err)
+		}
+		if _, err := w.Write(append(b, '\n')); err != nil {
+			return err
+		}
+		if f != nil {
+			if err := f.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
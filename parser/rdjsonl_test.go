@@ -1,8 +1,15 @@
 package parser
 
 import (
+	"bytes"
+	"errors"
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
 )
 
 func TestRDJSONLParser(t *testing.T) {
@@ -25,3 +32,47 @@ func TestRDJSONLParser(t *testing.T) {
 		}
 	}
 }
+
+func TestStreamWriteRDJSONL(t *testing.T) {
+	const sample = `{"message":"msg1","location":{"path":"a.go","range":{"start":{"line":1,"column":2}}},"originalOutput":"out1"}
+{"message":"msg2","severity":"WARNING","originalOutput":"out2"}`
+	ch := make(chan *rdf.Diagnostic)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- NewRDJSONLParser().ParseStream(strings.NewReader(sample), ch)
+		close(ch)
+	}()
+	var buf bytes.Buffer
+	if err := StreamWriteRDJSONL(&buf, ch); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := NewRDJSONLParser().Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewRDJSONLParser().Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("diagnostics diff (-want +got):\n%s", diff)
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errors.New("write error") }
+
+func TestStreamWriteRDJSONL_writeError(t *testing.T) {
+	ch := make(chan *rdf.Diagnostic, 2)
+	ch <- &rdf.Diagnostic{Message: "msg1"}
+	ch <- &rdf.Diagnostic{Message: "msg2"}
+	// ch is not closed, so StreamWriteRDJSONL must return on the write error.
+	if err := StreamWriteRDJSONL(errWriter{}, ch); err == nil {
+		t.Error("want write error")
+	}
+}
@@ -0,0 +1,496 @@
+package parser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &SarifParser{}
+
+// SarifParser is parser for SARIF (Static Analysis Results Interchange
+// Format) v2.1.0.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type SarifParser struct {
+	// allLocations reports a diagnostic for each location of a result instead
+	// of the first one only.
+	allLocations bool
+	// honorSuppressions drops suppressed results.
+	honorSuppressions bool
+	// includeCodeFlows reports locations of code flows as related locations,
+	// up to maxRelatedLocations.
+	includeCodeFlows    bool
+	maxRelatedLocations int
+	// fr reads files to convert byte offset regions to lines and columns.
+	fr FileReader
+	// severityFallback derives severities of results without levels from
+	// ranks with rankThresholds, then from properties.severity.
+	severityFallback bool
+	rankThresholds   *NumericSeverityThresholds
+	// strict validates required structure of SARIF.
+	strict bool
+}
+
+// NewSarifParser returns a new SarifParser.
+func NewSarifParser() *SarifParser {
+	return &SarifParser{}
+}
+
+// Parse parses SARIF.
+func (p *SarifParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var log SarifLog
+	if p.strict {
+		b, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateSarif(b); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &log); err != nil {
+			return nil, fmt.Errorf("failed to decode SARIF: %w", err)
+		}
+	} else if err := json.NewDecoder(r).Decode(&log); err != nil {
+		return nil, fmt.Errorf("failed to decode SARIF: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	contents := make(map[string][]byte)
+	for _, run := range log.Runs {
+		driver := &run.Tool.Driver
+		rules := make(map[string]*SarifRule, len(driver.Rules))
+		for _, rule := range driver.Rules {
+			rules[rule.ID] = rule
+		}
+		for _, result := range run.Results {
+			if p.honorSuppressions && result.suppressed() {
+				continue
+			}
+			rule := rules[result.RuleID]
+			if rule == nil && result.RuleIndex != nil && *result.RuleIndex >= 0 && *result.RuleIndex < len(driver.Rules) {
+				rule = driver.Rules[*result.RuleIndex]
+			}
+			var locations []*SarifLocation
+			for _, loc := range result.Locations {
+				if loc != nil { // Skip null entries.
+					locations = append(locations, loc)
+				}
+			}
+			if len(locations) == 0 {
+				locations = []*SarifLocation{{}}
+			} else if !p.allLocations {
+				locations = locations[:1]
+			}
+			for _, loc := range locations {
+				ds = append(ds, p.buildDiagnostic(contents, run, rule, result, loc))
+			}
+		}
+	}
+	return ds, nil
+}
+
+func (p *SarifParser) buildDiagnostic(contents map[string][]byte, run *SarifRun, rule *SarifRule, result *SarifResult, loc *SarifLocation) *rdf.Diagnostic {
+	driver := &run.Tool.Driver
+	level := result.Level
+	if level == "" && rule != nil && rule.DefaultConfiguration != nil {
+		level = rule.DefaultConfiguration.Level
+	}
+	if level == "" && p.severityFallback {
+		level = p.fallbackLevel(result)
+	}
+	if level == "" {
+		level = "warning" // Default level of SARIF.
+	}
+	path := run.artifactPath(&loc.PhysicalLocation.ArtifactLocation)
+	d := &rdf.Diagnostic{
+		Location: &rdf.Location{
+			Path:  path,
+			Range: p.regionRange(contents, path, loc.PhysicalLocation.Region),
+		},
+		Message:  result.Message.Text,
+		Severity: sarifSeverity(level),
+		Format:   "sarif",
+	}
+	if result.OccurrenceCount > 1 {
+		// The count is appended to the message by New after fingerprinting.
+		d.OccurrenceCount = int32(result.OccurrenceCount)
+	}
+	if driver.Name != "" {
+		d.Source = &rdf.Source{Name: driver.Name, Url: driver.InformationURI}
+	}
+	if result.RuleID != "" {
+		d.Code = &rdf.Code{Value: result.RuleID}
+		if rule != nil {
+			d.Code.Url = rule.HelpURI
+		}
+	}
+	for _, fix := range result.Fixes {
+		for _, change := range fix.ArtifactChanges {
+			if run.artifactPath(&change.ArtifactLocation) != path {
+				continue
+			}
+			for _, rep := range change.Replacements {
+				d.Suggestions = append(d.Suggestions, &rdf.Suggestion{
+					Range: p.regionRange(contents, path, rep.DeletedRegion),
+					Text:  rep.InsertedContent.Text,
+				})
+			}
+		}
+	}
+	if p.includeCodeFlows {
+		d.RelatedLocations = p.codeFlowLocations(contents, run, result)
+	}
+	d.OriginalOutput = fmt.Sprintf("%s:%d:%d: %s: %s (%s)", path,
+		d.GetLocation().GetRange().GetStart().GetLine(),
+		d.GetLocation().GetRange().GetStart().GetColumn(),
+		level, d.Message, result.RuleID)
+	return d
+}
+
+// fallbackLevel returns the level of the result derived from its rank or
+// properties.severity, or an empty string if neither is available.
+func (p *SarifParser) fallbackLevel(result *SarifResult) string {
+	sev := rdf.Severity_UNKNOWN_SEVERITY
+	if result.Rank != nil && *result.Rank >= 0 {
+		t := p.rankThresholds
+		if t == nil {
+			t = &DefaultSARIFRankThresholds
+		}
+		sev = t.severity(*result.Rank)
+	} else if result.Properties != nil {
+		sev = ParseSeverity(result.Properties.Severity)
+	}
+	switch sev {
+	case rdf.Severity_ERROR:
+		return "error"
+	case rdf.Severity_WARNING:
+		return "warning"
+	case rdf.Severity_INFO:
+		return "note"
+	default:
+		return ""
+	}
+}
+
+// codeFlowLocations returns locations of code flows of the result as related
+// locations. Locations beyond maxRelatedLocations are dropped.
+func (p *SarifParser) codeFlowLocations(contents map[string][]byte, run *SarifRun, result *SarifResult) []*rdf.RelatedLocation {
+	var related []*rdf.RelatedLocation
+	for _, flow := range result.CodeFlows {
+		for _, thread := range flow.ThreadFlows {
+			for _, tloc := range thread.Locations {
+				if p.maxRelatedLocations > 0 && len(related) >= p.maxRelatedLocations {
+					return related
+				}
+				loc := tloc.Location
+				if loc == nil {
+					continue
+				}
+				path := run.artifactPath(&loc.PhysicalLocation.ArtifactLocation)
+				related = append(related, &rdf.RelatedLocation{
+					Message: loc.Message.Text,
+					Location: &rdf.Location{
+						Path:  path,
+						Range: p.regionRange(contents, path, loc.PhysicalLocation.Region),
+					},
+				})
+			}
+		}
+	}
+	return related
+}
+
+// regionRange returns the range of the region in the file. Regions specified
+// by byteOffset instead of startLine are converted to lines and columns by
+// reading the file with FileReader, whose contents are cached in contents.
+func (p *SarifParser) regionRange(contents map[string][]byte, path string, r *SarifRegion) *rdf.Range {
+	if rng := r.rdfRange(); rng != nil || r == nil || r.ByteOffset == nil || p.fr == nil {
+		return rng
+	}
+	content, ok := contents[path]
+	if !ok {
+		content, _ = p.fr.ReadFile(path)
+		contents[path] = content
+	}
+	start := offsetPosition(content, *r.ByteOffset)
+	if start == nil {
+		return nil
+	}
+	rng := &rdf.Range{Start: start}
+	if r.ByteLength > 0 {
+		rng.End = offsetPosition(content, *r.ByteOffset+r.ByteLength)
+	}
+	return rng
+}
+
+// validateSarif returns an error describing the first missing required
+// structure of SARIF: version, runs, and tool.driver with name of each run.
+func validateSarif(b []byte) error {
+	var log struct {
+		Version *string `json:"version"`
+		Runs    *[]*struct {
+			Tool *struct {
+				Driver *struct {
+					Name *string `json:"name"`
+				} `json:"driver"`
+			} `json:"tool"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(b, &log); err != nil {
+		return fmt.Errorf("failed to decode SARIF: %w", err)
+	}
+	if log.Version == nil {
+		return errors.New("invalid SARIF: missing version")
+	}
+	if log.Runs == nil {
+		return errors.New("invalid SARIF: missing runs")
+	}
+	for i, run := range *log.Runs {
+		switch {
+		case run == nil:
+			return fmt.Errorf("invalid SARIF: runs[%d] is null", i)
+		case run.Tool == nil:
+			return fmt.Errorf("invalid SARIF: runs[%d]: missing tool", i)
+		case run.Tool.Driver == nil:
+			return fmt.Errorf("invalid SARIF: runs[%d]: missing tool.driver", i)
+		case run.Tool.Driver.Name == nil:
+			return fmt.Errorf("invalid SARIF: runs[%d]: missing tool.driver.name", i)
+		}
+	}
+	return nil
+}
+
+// artifactPath returns the file path of the artifact location whose uriBaseId
+// is resolved against originalUriBaseIds of the run.
+func (run *SarifRun) artifactPath(al *SarifArtifactLocation) string {
+	return sarifPath(run.artifactURI(al, make(map[string]bool)))
+}
+
+// artifactURI returns the URI of the artifact location resolved against its
+// base URI, or the URI as is if the base is unknown. seen guards against
+// cyclic base ids.
+func (run *SarifRun) artifactURI(al *SarifArtifactLocation, seen map[string]bool) string {
+	if al.URIBaseID == "" || seen[al.URIBaseID] {
+		return al.URI
+	}
+	seen[al.URIBaseID] = true
+	base := run.OriginalURIBaseIDs[al.URIBaseID]
+	if base == nil {
+		return al.URI
+	}
+	baseURI, err := url.Parse(run.artifactURI(base, seen))
+	if err != nil || baseURI.String() == "" {
+		return al.URI
+	}
+	ref, err := url.Parse(al.URI)
+	if err != nil {
+		return al.URI
+	}
+	return baseURI.ResolveReference(ref).String()
+}
+
+// sarifPath converts artifact URI to file path.
+func sarifPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	if u.Scheme == "file" {
+		return u.Path
+	}
+	if u.Scheme == "" {
+		// Relative reference. Unescape it (e.g. %20).
+		return u.Path
+	}
+	return uri
+}
+
+func sarifSeverity(level string) rdf.Severity {
+	switch level {
+	case "error":
+		return rdf.Severity_ERROR
+	case "warning":
+		return rdf.Severity_WARNING
+	case "note":
+		return rdf.Severity_INFO
+	default:
+		return rdf.Severity_UNKNOWN_SEVERITY
+	}
+}
+
+// SarifLog represents the top-level SARIF object.
+// {"version":"2.1.0","runs":[{"tool":{"driver":{"name":"tool"}},"results":[...]}]}
+type SarifLog struct {
+	Version string      `json:"version"`
+	Runs    []*SarifRun `json:"runs"`
+}
+
+// SarifRun represents a run of a tool.
+type SarifRun struct {
+	Tool    SarifTool      `json:"tool"`
+	Results []*SarifResult `json:"results"`
+	// OriginalURIBaseIDs maps uriBaseId to the base location.
+	// {"SRCROOT":{"uri":"file:///home/user/project/"}}
+	OriginalURIBaseIDs map[string]*SarifArtifactLocation `json:"originalUriBaseIds"`
+}
+
+// SarifTool represents the tool of a run.
+type SarifTool struct {
+	Driver SarifToolComponent `json:"driver"`
+}
+
+// SarifToolComponent represents tool.driver.
+type SarifToolComponent struct {
+	Name           string       `json:"name"`
+	InformationURI string       `json:"informationUri"`
+	Rules          []*SarifRule `json:"rules"`
+}
+
+// SarifRule represents a reportingDescriptor of a rule.
+type SarifRule struct {
+	ID                   string                  `json:"id"`
+	HelpURI              string                  `json:"helpUri"`
+	DefaultConfiguration *SarifRuleConfiguration `json:"defaultConfiguration"`
+}
+
+// SarifRuleConfiguration represents reportingConfiguration of a rule.
+type SarifRuleConfiguration struct {
+	Level string `json:"level"`
+}
+
+// SarifResult represents a result.
+type SarifResult struct {
+	RuleID       string              `json:"ruleId"`
+	RuleIndex    *int                `json:"ruleIndex"`
+	Level        string              `json:"level"`
+	Message      SarifMessage        `json:"message"`
+	Locations    []*SarifLocation    `json:"locations"`
+	Fixes        []*SarifFix         `json:"fixes"`
+	Suppressions []*SarifSuppression `json:"suppressions"`
+	CodeFlows    []*SarifCodeFlow    `json:"codeFlows"`
+	// Rank is 0.0 (lowest priority) to 100.0 (highest priority), or nil if
+	// absent.
+	Rank       *float64               `json:"rank"`
+	Properties *SarifResultProperties `json:"properties"`
+	// OccurrenceCount is the number of times the result was observed, or 0
+	// if absent.
+	OccurrenceCount int `json:"occurrenceCount"`
+}
+
+// SarifResultProperties represents the property bag of a result. Only
+// well-known properties are parsed.
+type SarifResultProperties struct {
+	Severity string `json:"severity"`
+}
+
+// suppressed reports whether the result is suppressed, i.e. it has a
+// suppression whose status is absent or "accepted".
+func (r *SarifResult) suppressed() bool {
+	for _, s := range r.Suppressions {
+		if s.Status == "" || s.Status == "accepted" {
+			return true
+		}
+	}
+	return false
+}
+
+// SarifSuppression represents a request to suppress a result (e.g. inline
+// comment or baseline).
+type SarifSuppression struct {
+	Kind          string `json:"kind"`
+	Status        string `json:"status"`
+	Justification string `json:"justification"`
+}
+
+// SarifMessage represents a message.
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+// SarifLocation represents a location.
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+	Message          SarifMessage          `json:"message"`
+}
+
+// SarifCodeFlow represents a code flow, which is progression of execution
+// through threads.
+type SarifCodeFlow struct {
+	ThreadFlows []*SarifThreadFlow `json:"threadFlows"`
+}
+
+// SarifThreadFlow represents a sequence of locations visited by a thread.
+type SarifThreadFlow struct {
+	Locations []*SarifThreadFlowLocation `json:"locations"`
+}
+
+// SarifThreadFlowLocation represents a step of a thread flow.
+type SarifThreadFlowLocation struct {
+	Location *SarifLocation `json:"location"`
+}
+
+// SarifPhysicalLocation represents a physical location.
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region"`
+}
+
+// SarifArtifactLocation represents a location of an artifact.
+type SarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+// SarifRegion represents a region of an artifact. Lines and columns are
+// 1-based. End column is exclusive. ByteOffset is 0-based and nil if absent.
+type SarifRegion struct {
+	StartLine   int  `json:"startLine"`
+	StartColumn int  `json:"startColumn"`
+	EndLine     int  `json:"endLine"`
+	EndColumn   int  `json:"endColumn"`
+	ByteOffset  *int `json:"byteOffset"`
+	ByteLength  int  `json:"byteLength"`
+}
+
+func (r *SarifRegion) rdfRange() *rdf.Range {
+	if r == nil || r.StartLine == 0 {
+		return nil
+	}
+	rng := &rdf.Range{
+		Start: &rdf.Position{Line: int32(r.StartLine), Column: int32(r.StartColumn)},
+	}
+	if r.EndLine != 0 || r.EndColumn != 0 {
+		endLine := r.EndLine
+		if endLine == 0 {
+			endLine = r.StartLine // endLine defaults to startLine.
+		}
+		rng.End = &rdf.Position{Line: int32(endLine), Column: int32(r.EndColumn)}
+	}
+	return rng
+}
+
+// SarifFix represents a proposed fix.
+type SarifFix struct {
+	ArtifactChanges []*SarifArtifactChange `json:"artifactChanges"`
+}
+
+// SarifArtifactChange represents changes to an artifact.
+type SarifArtifactChange struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []*SarifReplacement   `json:"replacements"`
+}
+
+// SarifReplacement represents a replacement of a region.
+type SarifReplacement struct {
+	DeletedRegion   *SarifRegion         `json:"deletedRegion"`
+	InsertedContent SarifArtifactContent `json:"insertedContent"`
+}
+
+// SarifArtifactContent represents content of an artifact.
+type SarifArtifactContent struct {
+	Text string `json:"text"`
+}
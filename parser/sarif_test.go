@@ -0,0 +1,622 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func ExampleSarifParser() {
+	const sample = `{
+  "version": "2.1.0",
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "super-lint",
+          "informationUri": "https://example.com/super-lint",
+          "rules": [
+            {"id": "no-unused", "helpUri": "https://example.com/rules/no-unused", "defaultConfiguration": {"level": "error"}},
+            {"id": "prefer-const"}
+          ]
+        }
+      },
+      "results": [
+        {
+          "ruleId": "no-unused",
+          "message": {"text": "'x' is unused"},
+          "locations": [
+            {"physicalLocation": {"artifactLocation": {"uri": "src/main.js"}, "region": {"startLine": 3, "startColumn": 7, "endColumn": 8}}}
+          ]
+        },
+        {
+          "ruleId": "prefer-const",
+          "ruleIndex": 1,
+          "level": "note",
+          "message": {"text": "'y' is never reassigned. Use 'const' instead"},
+          "locations": [
+            {"physicalLocation": {"artifactLocation": {"uri": "file:///home/user/src/util.js"}, "region": {"startLine": 10, "startColumn": 1, "endLine": 10, "endColumn": 4}}}
+          ],
+          "fixes": [
+            {
+              "artifactChanges": [
+                {
+                  "artifactLocation": {"uri": "file:///home/user/src/util.js"},
+                  "replacements": [
+                    {"deletedRegion": {"startLine": 10, "startColumn": 1, "endColumn": 4}, "insertedContent": {"text": "const"}}
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+
+	p := NewSarifParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "'x' is unused",
+	//   "location": {
+	//     "path": "src/main.js",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 7
+	//       },
+	//       "end": {
+	//         "line": 3,
+	//         "column": 8
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "source": {
+	//     "name": "super-lint",
+	//     "url": "https://example.com/super-lint"
+	//   },
+	//   "code": {
+	//     "value": "no-unused",
+	//     "url": "https://example.com/rules/no-unused"
+	//   },
+	//   "originalOutput": "src/main.js:3:7: error: 'x' is unused (no-unused)",
+	//   "format": "sarif"
+	// }
+	// {
+	//   "message": "'y' is never reassigned. Use 'const' instead",
+	//   "location": {
+	//     "path": "/home/user/src/util.js",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 10,
+	//         "column": 4
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "source": {
+	//     "name": "super-lint",
+	//     "url": "https://example.com/super-lint"
+	//   },
+	//   "code": {
+	//     "value": "prefer-const"
+	//   },
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 10,
+	//           "column": 1
+	//         },
+	//         "end": {
+	//           "line": 10,
+	//           "column": 4
+	//         }
+	//       },
+	//       "text": "const"
+	//     }
+	//   ],
+	//   "originalOutput": "/home/user/src/util.js:10:1: note: 'y' is never reassigned. Use 'const' instead (prefer-const)",
+	//   "format": "sarif"
+	// }
+}
+
+func TestSarifParser_allLocations(t *testing.T) {
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "dup-finder"}},
+      "results": [
+        {
+          "ruleId": "duplicate-code",
+          "level": "warning",
+          "message": {"text": "duplicated code"},
+          "locations": [
+            null,
+            {"physicalLocation": {"artifactLocation": {"uri": "a.go"}, "region": {"startLine": 1}}},
+            null,
+            {"physicalLocation": {"artifactLocation": {"uri": "b.go"}, "region": {"startLine": 5}}}
+          ]
+        }
+      ]
+    }
+  ]
+}`
+	tests := []struct {
+		allLocations bool
+		want         []string
+	}{
+		{allLocations: false, want: []string{"a.go:1"}},
+		{allLocations: true, want: []string{"a.go:1", "b.go:5"}},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "sarif", SARIFAllLocations: tt.allLocations})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != len(tt.want) {
+			t.Fatalf("SARIFAllLocations=%v: got %d diagnostics, want %d", tt.allLocations, len(ds), len(tt.want))
+		}
+		for i, d := range ds {
+			got := fmt.Sprintf("%s:%d", d.GetLocation().GetPath(), d.GetLocation().GetRange().GetStart().GetLine())
+			if got != tt.want[i] {
+				t.Errorf("SARIFAllLocations=%v: %d: got %s, want %s", tt.allLocations, i, got, tt.want[i])
+			}
+			if d.GetMessage() != "duplicated code" || d.GetCode().GetValue() != "duplicate-code" {
+				t.Errorf("SARIFAllLocations=%v: %d: message and code should be shared: %v", tt.allLocations, i, d)
+			}
+		}
+	}
+}
+
+func TestSarifParser_honorSuppressions(t *testing.T) {
+	// golangci-lint run --out-format=sarif
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "golangci-lint"}},
+      "results": [
+        {
+          "ruleId": "errcheck",
+          "level": "error",
+          "message": {"text": "suppressed"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "a.go"}, "region": {"startLine": 1}}}],
+          "suppressions": [{"kind": "inSource", "justification": "nolint"}]
+        },
+        {
+          "ruleId": "errcheck",
+          "level": "error",
+          "message": {"text": "rejected suppression"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "a.go"}, "region": {"startLine": 3}}}],
+          "suppressions": [{"kind": "external", "status": "rejected"}]
+        },
+        {
+          "ruleId": "errcheck",
+          "level": "error",
+          "message": {"text": "active"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "a.go"}, "region": {"startLine": 5}}}]
+        }
+      ]
+    }
+  ]
+}`
+	tests := []struct {
+		honorSuppressions bool
+		want              []string
+	}{
+		{honorSuppressions: false, want: []string{"suppressed", "rejected suppression", "active"}},
+		{honorSuppressions: true, want: []string{"rejected suppression", "active"}},
+	}
+	for _, tt := range tests {
+		p, err := New(&Option{FormatName: "sarif", HonorSuppressions: tt.honorSuppressions})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != len(tt.want) {
+			t.Fatalf("HonorSuppressions=%v: got %d diagnostics, want %d", tt.honorSuppressions, len(ds), len(tt.want))
+		}
+		for i, d := range ds {
+			if got := d.GetMessage(); got != tt.want[i] {
+				t.Errorf("HonorSuppressions=%v: %d: got %q, want %q", tt.honorSuppressions, i, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestSarifParser_includeCodeFlows(t *testing.T) {
+	// CodeQL reports taint tracking paths as code flows.
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "CodeQL"}},
+      "results": [
+        {
+          "ruleId": "go/sql-injection",
+          "level": "error",
+          "message": {"text": "This query depends on a user-provided value."},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "db.go"}, "region": {"startLine": 30, "startColumn": 14}}}],
+          "codeFlows": [
+            {
+              "threadFlows": [
+                {
+                  "locations": [
+                    {"location": {"physicalLocation": {"artifactLocation": {"uri": "handler.go"}, "region": {"startLine": 12, "startColumn": 9}}, "message": {"text": "selection of FormValue"}}},
+                    {"location": {"physicalLocation": {"artifactLocation": {"uri": "handler.go"}, "region": {"startLine": 15, "startColumn": 20}}, "message": {"text": "name"}}},
+                    {"location": {"physicalLocation": {"artifactLocation": {"uri": "db.go"}, "region": {"startLine": 28, "startColumn": 2}}, "message": {"text": "query"}}}
+                  ]
+                }
+              ]
+            },
+            {
+              "threadFlows": [
+                {
+                  "locations": [
+                    {"location": {"physicalLocation": {"artifactLocation": {"uri": "db.go"}, "region": {"startLine": 30, "startColumn": 14}}, "message": {"text": "query"}}}
+                  ]
+                }
+              ]
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+	all := []*rdf.RelatedLocation{
+		{Message: "selection of FormValue", Location: &rdf.Location{Path: "handler.go", Range: &rdf.Range{Start: &rdf.Position{Line: 12, Column: 9}}}},
+		{Message: "name", Location: &rdf.Location{Path: "handler.go", Range: &rdf.Range{Start: &rdf.Position{Line: 15, Column: 20}}}},
+		{Message: "query", Location: &rdf.Location{Path: "db.go", Range: &rdf.Range{Start: &rdf.Position{Line: 28, Column: 2}}}},
+		{Message: "query", Location: &rdf.Location{Path: "db.go", Range: &rdf.Range{Start: &rdf.Position{Line: 30, Column: 14}}}},
+	}
+	tests := []struct {
+		opt  *Option
+		want []*rdf.RelatedLocation
+	}{
+		{opt: &Option{FormatName: "sarif"}, want: nil},
+		{opt: &Option{FormatName: "sarif", IncludeCodeFlows: true}, want: all},
+		{opt: &Option{FormatName: "sarif", IncludeCodeFlows: true, MaxRelatedLocations: 2}, want: all[:2]},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != 1 {
+			t.Fatalf("got %d diagnostics, want 1", len(ds))
+		}
+		if diff := cmp.Diff(tt.want, ds[0].GetRelatedLocations(), protocmp.Transform()); diff != "" {
+			t.Errorf("IncludeCodeFlows=%v, MaxRelatedLocations=%d: related locations (-want +got):\n%s",
+				tt.opt.IncludeCodeFlows, tt.opt.MaxRelatedLocations, diff)
+		}
+	}
+}
+
+func TestSarifParser_byteOffset(t *testing.T) {
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "golangci-lint"}},
+      "results": [
+        {
+          "ruleId": "unused",
+          "message": {"text": "func foo is unused"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go"}, "region": {"byteOffset": 29, "byteLength": 5}}}]
+        },
+        {
+          "ruleId": "unused",
+          "message": {"text": "out of file"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go"}, "region": {"byteOffset": 100}}}]
+        }
+      ]
+    }
+  ]
+}`
+	fr := fakeFileReader{"main.go": "package main\n\nfunc main() {\n\tfoo()\n}\n"}
+	tests := []struct {
+		opt  *Option
+		want []*rdf.Range
+	}{
+		{
+			opt:  &Option{FormatName: "sarif"},
+			want: []*rdf.Range{nil, nil},
+		},
+		{
+			opt: &Option{FormatName: "sarif", FileReader: fr},
+			want: []*rdf.Range{
+				{Start: &rdf.Position{Line: 4, Column: 2}, End: &rdf.Position{Line: 4, Column: 7}},
+				nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []*rdf.Range
+		for _, d := range ds {
+			got = append(got, d.GetLocation().GetRange())
+		}
+		if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("FileReader=%v: ranges (-want +got):\n%s", tt.opt.FileReader != nil, diff)
+		}
+	}
+}
+
+func TestSarifParser_severityFallback(t *testing.T) {
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "scanner", "rules": [{"id": "default-note", "defaultConfiguration": {"level": "note"}}]}},
+      "results": [
+        {"ruleId": "explicit", "level": "error", "rank": 10, "message": {"text": "level"}},
+        {"ruleId": "default-note", "rank": 95, "message": {"text": "rule default"}},
+        {"ruleId": "r", "rank": 95, "message": {"text": "high rank"}},
+        {"ruleId": "r", "rank": 50, "message": {"text": "middle rank"}},
+        {"ruleId": "r", "rank": 5, "properties": {"severity": "critical"}, "message": {"text": "low rank"}},
+        {"ruleId": "r", "properties": {"severity": "critical"}, "message": {"text": "properties"}},
+        {"ruleId": "r", "properties": {"tags": ["security"]}, "message": {"text": "none"}}
+      ]
+    }
+  ]
+}`
+	tests := []struct {
+		opt  *Option
+		want []rdf.Severity
+	}{
+		{
+			opt: &Option{FormatName: "sarif"},
+			want: []rdf.Severity{
+				rdf.Severity_ERROR, rdf.Severity_INFO, rdf.Severity_WARNING, rdf.Severity_WARNING,
+				rdf.Severity_WARNING, rdf.Severity_WARNING, rdf.Severity_WARNING,
+			},
+		},
+		{
+			opt: &Option{FormatName: "sarif", SARIFSeverityFallback: true},
+			want: []rdf.Severity{
+				rdf.Severity_ERROR, rdf.Severity_INFO, rdf.Severity_ERROR, rdf.Severity_WARNING,
+				rdf.Severity_INFO, rdf.Severity_ERROR, rdf.Severity_WARNING,
+			},
+		},
+		{
+			opt: &Option{
+				FormatName:                "sarif",
+				SARIFSeverityFallback:     true,
+				NumericSeverityThresholds: &NumericSeverityThresholds{Error: 99, Warning: 50},
+			},
+			want: []rdf.Severity{
+				rdf.Severity_ERROR, rdf.Severity_INFO, rdf.Severity_WARNING, rdf.Severity_WARNING,
+				rdf.Severity_INFO, rdf.Severity_ERROR, rdf.Severity_WARNING,
+			},
+		},
+	}
+	for _, tt := range tests {
+		p, err := New(tt.opt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []rdf.Severity
+		for _, d := range ds {
+			got = append(got, d.GetSeverity())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("SARIFSeverityFallback=%v, NumericSeverityThresholds=%v: severities (-want +got):\n%s",
+				tt.opt.SARIFSeverityFallback, tt.opt.NumericSeverityThresholds, diff)
+		}
+	}
+}
+
+func TestSarifParser_uriBaseID(t *testing.T) {
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "golangci-lint"}},
+      "originalUriBaseIds": {
+        "SRCROOT": {"uri": "file:///home/user/project/"},
+        "PKGROOT": {"uri": "pkg/", "uriBaseId": "SRCROOT"}
+      },
+      "results": [
+        {
+          "ruleId": "unused",
+          "message": {"text": "from SRCROOT"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go", "uriBaseId": "SRCROOT"}, "region": {"startLine": 1}}}]
+        },
+        {
+          "ruleId": "unused",
+          "message": {"text": "from nested PKGROOT"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "foo/foo.go", "uriBaseId": "PKGROOT"}, "region": {"startLine": 2}}}]
+        },
+        {
+          "ruleId": "unused",
+          "message": {"text": "unknown base"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "bar.go", "uriBaseId": "UNKNOWN"}, "region": {"startLine": 3}}}]
+        }
+      ]
+    }
+  ]
+}`
+	ds, err := NewSarifParser().Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"/home/user/project/main.go",
+		"/home/user/project/pkg/foo/foo.go",
+		"bar.go",
+	}
+	var got []string
+	for _, d := range ds {
+		got = append(got, d.GetLocation().GetPath())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("paths (-want +got):\n%s", diff)
+	}
+}
+
+func TestSarifParser_strict(t *testing.T) {
+	tests := []struct {
+		sample  string
+		wantErr string
+	}{
+		{
+			sample: `{"version":"2.1.0","runs":[{"tool":{"driver":{"name":"tool"}},"results":[]}]}`,
+		},
+		{
+			sample:  `{"runs":[]}`,
+			wantErr: "invalid SARIF: missing version",
+		},
+		{
+			sample:  `{"version":"2.1.0"}`,
+			wantErr: "invalid SARIF: missing runs",
+		},
+		{
+			sample:  `{"version":"2.1.0","runs":[{"tool":{"driver":{"name":"tool"}}},{"results":[]}]}`,
+			wantErr: "invalid SARIF: runs[1]: missing tool",
+		},
+		{
+			sample:  `{"version":"2.1.0","runs":[{"tool":{}}]}`,
+			wantErr: "invalid SARIF: runs[0]: missing tool.driver",
+		},
+		{
+			sample:  `{"version":"2.1.0","runs":[{"tool":{"driver":{}}}]}`,
+			wantErr: "invalid SARIF: runs[0]: missing tool.driver.name",
+		},
+	}
+	p, err := New(&Option{FormatName: "sarif", SARIFStrict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		_, err := p.Parse(strings.NewReader(tt.sample))
+		var got string
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.wantErr {
+			t.Errorf("%s: got error %q, want %q", tt.sample, got, tt.wantErr)
+		}
+		if _, err := NewSarifParser().Parse(strings.NewReader(tt.sample)); err != nil {
+			t.Errorf("%s: lenient parser returned error: %v", tt.sample, err)
+		}
+	}
+}
+
+func TestSarifParser_occurrenceCount(t *testing.T) {
+	const sample = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "golangci-lint"}},
+      "results": [
+        {
+          "ruleId": "dupword",
+          "message": {"text": "Duplicate words (the) found"},
+          "occurrenceCount": 3,
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go"}, "region": {"startLine": 1}}}]
+        },
+        {
+          "ruleId": "dupword",
+          "message": {"text": "Duplicate words (a) found"},
+          "occurrenceCount": 1,
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go"}, "region": {"startLine": 2}}}]
+        },
+        {
+          "ruleId": "dupword",
+          "message": {"text": "Duplicate words (is) found"},
+          "locations": [{"physicalLocation": {"artifactLocation": {"uri": "main.go"}, "region": {"startLine": 3}}}]
+        }
+      ]
+    }
+  ]
+}`
+	want := []string{
+		"Duplicate words (the) found (3 occurrences): 3",
+		"Duplicate words (a) found: 0",
+		"Duplicate words (is) found: 0",
+	}
+	for _, countDuplicates := range []bool{false, true} {
+		p, err := New(&Option{FormatName: "sarif", CountDuplicates: countDuplicates})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ds, err := p.Parse(strings.NewReader(sample))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, d := range ds {
+			got = append(got, fmt.Sprintf("%s: %d", d.GetMessage(), d.GetOccurrenceCount()))
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("CountDuplicates=%v: messages and occurrence counts (-want +got):\n%s", countDuplicates, diff)
+		}
+	}
+
+	// Fingerprints don't depend on the count.
+	seen := make(map[string]bool)
+	p, err := New(&Option{FormatName: "sarif", SeenFingerprints: seen})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Parse(strings.NewReader(sample)); err != nil {
+		t.Fatal(err)
+	}
+	ds, err := p.Parse(strings.NewReader(strings.Replace(sample, `"occurrenceCount": 3`, `"occurrenceCount": 4`, 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 0 {
+		t.Errorf("got %d diagnostics after the count changed, want 0: %v", len(ds), ds)
+	}
+}
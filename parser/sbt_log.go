@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &SbtParser{}
+
+// sbtLineRe matches level-prefixed sbt diagnostic lines.
+//
+//	[warn] path:line:col: message
+//	[error] path:line: message
+var sbtLineRe = regexp.MustCompile(`^\[(error|warn|info)\] (\S.*?):(\d+)(?::(\d+))?: (.*)$`)
+
+// SbtParser is parser for level-prefixed sbt output, e.g. of scalac or
+// scalafix run via sbt.
+//
+//	[warn] path:line:col: message
+//	[error] path:line: message
+//
+// https://www.scala-sbt.org/
+type SbtParser struct{}
+
+// NewSbtParser returns a new SbtParser.
+func NewSbtParser() *SbtParser {
+	return &SbtParser{}
+}
+
+// Parse parses sbt output. Lines without path and line number, such as
+// interleaved [info] logs and source excerpts, are ignored.
+func (p *SbtParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := sbtLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[3])
+		col, _ := strconv.Atoi(m[4])
+		ds = append(ds, &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[2],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[5],
+			Severity:       ParseSeverity(m[1]),
+			Format:         "sbt-log",
+			OriginalOutput: line,
+		})
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleSbtParser() {
+	// sbt compile scalafix
+	const sample = `[info] welcome to sbt 1.9.7 (Eclipse Adoptium Java 17.0.9)
+[info] compiling 2 Scala sources to /app/target/scala-2.13/classes ...
+[warn] /app/src/main/scala/App.scala:12:7: private val unused in object App is never used
+[warn]   private val unused = 1
+[warn]       ^
+[error] /app/src/main/scala/Util.scala:3: not found: value foo
+[error]   foo()
+[error]   ^
+[warn] one warning found
+[error] one error found
+[error] (Compile / compileIncremental) Compilation failed`
+
+	p := NewSbtParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "private val unused in object App is never used",
+	//   "location": {
+	//     "path": "/app/src/main/scala/App.scala",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 7
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "[warn] /app/src/main/scala/App.scala:12:7: private val unused in object App is never used",
+	//   "format": "sbt-log"
+	// }
+	// {
+	//   "message": "not found: value foo",
+	//   "location": {
+	//     "path": "/app/src/main/scala/Util.scala",
+	//     "range": {
+	//       "start": {
+	//         "line": 3
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "[error] /app/src/main/scala/Util.scala:3: not found: value foo",
+	//   "format": "sbt-log"
+	// }
+}
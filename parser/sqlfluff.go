@@ -0,0 +1,75 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &SqlfluffParser{}
+
+// SqlfluffParser is parser for sqlfluff JSON output (sqlfluff lint --format json).
+// https://github.com/sqlfluff/sqlfluff
+type SqlfluffParser struct{}
+
+// NewSqlfluffParser returns a new SqlfluffParser.
+func NewSqlfluffParser() *SqlfluffParser {
+	return &SqlfluffParser{}
+}
+
+// Parse parses sqlfluff JSON output.
+func (p *SqlfluffParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var files []*SqlfluffFile
+	if err := json.NewDecoder(r).Decode(&files); err != nil {
+		return nil, fmt.Errorf("failed to decode sqlfluff JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, f := range files {
+		for _, v := range f.Violations {
+			lnum, col := v.LineNo, v.LinePos
+			if lnum == 0 {
+				lnum, col = v.StartLineNo, v.StartLinePos
+			}
+			d := &rdf.Diagnostic{
+				Location: &rdf.Location{
+					Path: f.Filepath,
+					Range: &rdf.Range{
+						Start: &rdf.Position{
+							Line:   int32(lnum),
+							Column: int32(col),
+						},
+					},
+				},
+				Message:        v.Description,
+				Severity:       rdf.Severity_WARNING,
+				Format:         "sqlfluff",
+				OriginalOutput: fmt.Sprintf("%s:%d:%d: %s: %s", f.Filepath, lnum, col, v.Code, v.Description),
+			}
+			if v.Code != "" {
+				d.Code = &rdf.Code{Value: v.Code}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+// SqlfluffFile represents violations of a file.
+// [{"filepath":"query.sql","violations":[{"line_no":1,"line_pos":1,"code":"L010","description":"msg"}]}]
+type SqlfluffFile struct {
+	Filepath   string               `json:"filepath"`
+	Violations []*SqlfluffViolation `json:"violations"`
+}
+
+// SqlfluffViolation represents a violation. Newer sqlfluff reports
+// start_line_no/start_line_pos instead of line_no/line_pos.
+type SqlfluffViolation struct {
+	LineNo       int    `json:"line_no"`
+	LinePos      int    `json:"line_pos"`
+	StartLineNo  int    `json:"start_line_no"`
+	StartLinePos int    `json:"start_line_pos"`
+	Code         string `json:"code"`
+	Description  string `json:"description"`
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleSqlfluffParser() {
+	const sample = `[
+  {
+    "filepath": "models/orders.sql",
+    "violations": [
+      {"line_no": 1, "line_pos": 1, "code": "L010", "description": "Keywords must be consistently upper case."},
+      {"line_no": 3, "line_pos": 12, "code": "L039", "description": "Unnecessary whitespace found."}
+    ]
+  },
+  {
+    "filepath": "models/users.sql",
+    "violations": [
+      {"start_line_no": 7, "start_line_pos": 5, "code": "LT01", "description": "Expected only single space before 'AS' keyword."}
+    ]
+  },
+  {"filepath": "models/clean.sql", "violations": []}
+]`
+
+	p := NewSqlfluffParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Keywords must be consistently upper case.",
+	//   "location": {
+	//     "path": "models/orders.sql",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "L010"
+	//   },
+	//   "originalOutput": "models/orders.sql:1:1: L010: Keywords must be consistently upper case.",
+	//   "format": "sqlfluff"
+	// }
+	// {
+	//   "message": "Unnecessary whitespace found.",
+	//   "location": {
+	//     "path": "models/orders.sql",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 12
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "L039"
+	//   },
+	//   "originalOutput": "models/orders.sql:3:12: L039: Unnecessary whitespace found.",
+	//   "format": "sqlfluff"
+	// }
+	// {
+	//   "message": "Expected only single space before 'AS' keyword.",
+	//   "location": {
+	//     "path": "models/users.sql",
+	//     "range": {
+	//       "start": {
+	//         "line": 7,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "LT01"
+	//   },
+	//   "originalOutput": "models/users.sql:7:5: LT01: Expected only single space before 'AS' keyword.",
+	//   "format": "sqlfluff"
+	// }
+}
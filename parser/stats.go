@@ -0,0 +1,82 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+// ParseStats describes input and results of a Parse call.
+type ParseStats struct {
+	// Lines is the number of input lines read.
+	Lines int
+	// Total is the number of diagnostics reported by the parser.
+	Total int
+	// Valid is the number of diagnostics returned after post-processing.
+	Valid int
+	// Skipped is the number of diagnostics dropped by post-processing (e.g.
+	// DropSeverities and SeenFingerprints).
+	Skipped int
+	// BySeverity is the number of valid diagnostics by severity.
+	BySeverity map[rdf.Severity]int
+	// ParseWarnings describes non-fatal parse problems, e.g. "3 of 10 lines
+	// skipped" and "2 diagnostics had no location".
+	ParseWarnings []string
+}
+
+// WriteParseStats writes stats as JSON, e.g. for CI dashboards to record
+// parse health over time. Severities are keyed by name.
+//
+//	{"lines":3,"total":3,"valid":2,"skipped":1,"bySeverity":{"ERROR":1,"WARNING":1}}
+func WriteParseStats(w io.Writer, stats ParseStats) error {
+	bySeverity := make(map[string]int, len(stats.BySeverity))
+	for s, n := range stats.BySeverity {
+		bySeverity[s.String()] = n
+	}
+	out := struct {
+		Lines      int            `json:"lines"`
+		Total      int            `json:"total"`
+		Valid      int            `json:"valid"`
+		Skipped    int            `json:"skipped"`
+		BySeverity map[string]int `json:"bySeverity"`
+		Warnings   []string       `json:"parseWarnings,omitempty"`
+	}{
+		Lines:      stats.Lines,
+		Total:      stats.Total,
+		Valid:      stats.Valid,
+		Skipped:    stats.Skipped,
+		BySeverity: bySeverity,
+		Warnings:   stats.ParseWarnings,
+	}
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		return fmt.Errorf("failed to encode parse stats JSON: %w", err)
+	}
+	return nil
+}
+
+// lineCountReader counts lines read from r. A last line without newline is
+// counted as well.
+type lineCountReader struct {
+	r       io.Reader
+	lines   int
+	partial bool
+}
+
+func (r *lineCountReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.lines += bytes.Count(p[:n], []byte{'\n'})
+		r.partial = p[n-1] != '\n'
+	}
+	return n, err
+}
+
+func (r *lineCountReader) count() int {
+	if r.partial {
+		return r.lines + 1
+	}
+	return r.lines
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func TestWriteParseStats(t *testing.T) {
+	tests := []struct {
+		stats ParseStats
+		want  string
+	}{
+		{
+			stats: ParseStats{
+				Lines:      3,
+				Total:      3,
+				Valid:      2,
+				Skipped:    1,
+				BySeverity: map[rdf.Severity]int{rdf.Severity_WARNING: 1, rdf.Severity_ERROR: 1},
+			},
+			want: `{"lines":3,"total":3,"valid":2,"skipped":1,"bySeverity":{"ERROR":1,"WARNING":1}}`,
+		},
+		{
+			stats: ParseStats{},
+			want:  `{"lines":0,"total":0,"valid":0,"skipped":0,"bySeverity":{}}`,
+		},
+	}
+	for _, tt := range tests {
+		var b strings.Builder
+		if err := WriteParseStats(&b, tt.stats); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.TrimSpace(b.String()); got != tt.want {
+			t.Errorf("got %s, want %s", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &StatusTableParser{}
+
+// StatusTableOption configures columns of StatusTableParser. Columns are
+// whitespace-delimited and 1-based. Defaults are used for zero fields.
+type StatusTableOption struct {
+	// FailKeyword is the status of failed rows, reported as ERROR. Rows with
+	// other statuses (e.g. PASS) are ignored. Default: FAIL.
+	FailKeyword string
+	// StatusColumn is the column of statuses. Default: 1.
+	StatusColumn int
+	// PathColumn is the column of paths. Default: 2.
+	PathColumn int
+	// ReasonColumn is the first column of reasons. The rest of the line from
+	// the column is the reason, so reasons may contain spaces. Default: the
+	// column after PathColumn.
+	ReasonColumn int
+}
+
+// StatusTableParser is parser for tables of check statuses per file emitted
+// by tools like licheck.
+//
+//	PASS  path/to/ok.go
+//	FAIL  path/to/file.go  reason with spaces
+//
+// Diagnostics are file-level as such tools don't report positions.
+type StatusTableParser struct {
+	opt StatusTableOption
+}
+
+// NewStatusTableParser returns a new StatusTableParser.
+func NewStatusTableParser(opt StatusTableOption) *StatusTableParser {
+	if opt.FailKeyword == "" {
+		opt.FailKeyword = "FAIL"
+	}
+	if opt.StatusColumn <= 0 {
+		opt.StatusColumn = 1
+	}
+	if opt.PathColumn <= 0 {
+		opt.PathColumn = 2
+	}
+	if opt.ReasonColumn <= 0 {
+		opt.ReasonColumn = opt.PathColumn + 1
+	}
+	return &StatusTableParser{opt: opt}
+}
+
+var statusTableFieldRe = regexp.MustCompile(`\S+`)
+
+// Parse parses status tables. Rows without the path column are ignored.
+func (p *StatusTableParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		fields := statusTableFieldRe.FindAllStringIndex(line, -1)
+		field := func(col int) string {
+			if col > len(fields) {
+				return ""
+			}
+			return line[fields[col-1][0]:fields[col-1][1]]
+		}
+		if field(p.opt.StatusColumn) != p.opt.FailKeyword {
+			continue
+		}
+		path := field(p.opt.PathColumn)
+		if path == "" {
+			continue
+		}
+		var reason string
+		if p.opt.ReasonColumn <= len(fields) {
+			reason = strings.TrimSpace(line[fields[p.opt.ReasonColumn-1][0]:])
+		}
+		if reason == "" {
+			reason = p.opt.FailKeyword
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: path},
+			Message:        reason,
+			Severity:       rdf.Severity_ERROR,
+			Format:         "status-table",
+			OriginalOutput: line,
+		})
+	}
+	return ds, s.Err()
+}
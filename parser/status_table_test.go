@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleStatusTableParser() {
+	const sample = `STATUS  FILE                 REASON
+PASS    go.mod
+FAIL    vendor/foo/LICENSE   license GPL-3.0 is not allowed
+FAIL    web/package.json     unknown license:  see   NOTICE
+FAIL
+`
+
+	p := NewStatusTableParser(StatusTableOption{})
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "license GPL-3.0 is not allowed",
+	//   "location": {
+	//     "path": "vendor/foo/LICENSE"
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "FAIL    vendor/foo/LICENSE   license GPL-3.0 is not allowed",
+	//   "format": "status-table"
+	// }
+	// {
+	//   "message": "unknown license:  see   NOTICE",
+	//   "location": {
+	//     "path": "web/package.json"
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "FAIL    web/package.json     unknown license:  see   NOTICE",
+	//   "format": "status-table"
+	// }
+}
+
+func ExampleStatusTableParser_columns() {
+	// Status is the last column of the table.
+	const sample = `1  src/a.c  ok      -
+2  src/b.c  FAILED  missing copyright header
+`
+
+	p := NewStatusTableParser(StatusTableOption{
+		FailKeyword:  "FAILED",
+		StatusColumn: 3,
+		PathColumn:   2,
+		ReasonColumn: 4,
+	})
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		fmt.Printf("%s: %s\n", d.GetLocation().GetPath(), d.GetMessage())
+	}
+	// Output:
+	// src/b.c: missing copyright header
+}
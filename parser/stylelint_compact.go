@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &StylelintCompactParser{}
+
+// StylelintCompactParser is parser for stylelint compact text output.
+// https://stylelint.io/user-guide/usage/options#formatter
+type StylelintCompactParser struct{}
+
+// NewStylelintCompactParser returns a new StylelintCompactParser.
+func NewStylelintCompactParser() *StylelintCompactParser {
+	return &StylelintCompactParser{}
+}
+
+// path:line:col: message [rule]
+var stylelintCompactRe = regexp.MustCompile(`^(.+?):(\d+):(\d+): (.*?)(?: \[([^\]]+)\])?$`)
+
+// Parse parses stylelint compact output. Lines which don't look like
+// diagnostics are ignored.
+func (p *StylelintCompactParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := stylelintCompactRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[4],
+			Format:         "stylelint-compact",
+			OriginalOutput: s.Text(),
+		}
+		if rule := m[5]; rule != "" {
+			d.Code = &rdf.Code{Value: rule}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleStylelintCompactParser() {
+	const sample = `src/app.css:3:5: Expected indentation of 2 spaces [indentation]
+src/app.css:10:1: Unexpected empty block [block-no-empty]
+src/legacy.css:1:1: Unknown word
+
+2 problems found`
+
+	p := NewStylelintCompactParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Expected indentation of 2 spaces",
+	//   "location": {
+	//     "path": "src/app.css",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "indentation"
+	//   },
+	//   "originalOutput": "src/app.css:3:5: Expected indentation of 2 spaces [indentation]",
+	//   "format": "stylelint-compact"
+	// }
+	// {
+	//   "message": "Unexpected empty block",
+	//   "location": {
+	//     "path": "src/app.css",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "block-no-empty"
+	//   },
+	//   "originalOutput": "src/app.css:10:1: Unexpected empty block [block-no-empty]",
+	//   "format": "stylelint-compact"
+	// }
+	// {
+	//   "message": "Unknown word",
+	//   "location": {
+	//     "path": "src/legacy.css",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "originalOutput": "src/legacy.css:1:1: Unknown word",
+	//   "format": "stylelint-compact"
+	// }
+}
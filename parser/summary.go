@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"sort"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+// CheckSummaryTopN is the max number of diagnostics in CheckSummary.Top.
+const CheckSummaryTopN = 10
+
+// CheckSummary is an aggregated summary of diagnostics, e.g. for the summary
+// of GitHub check runs.
+type CheckSummary struct {
+	// Total is the number of diagnostics.
+	Total int
+	// Counts is the number of diagnostics by severity.
+	Counts map[rdf.Severity]int
+	// Top is the most severe diagnostics (at most CheckSummaryTopN) ordered
+	// by severity, path and position.
+	Top []*rdf.Diagnostic
+}
+
+// BuildCheckSummary returns CheckSummary of diagnostics. ds is not modified.
+func BuildCheckSummary(ds []*rdf.Diagnostic) CheckSummary {
+	summary := CheckSummary{
+		Total:  len(ds),
+		Counts: make(map[rdf.Severity]int),
+	}
+	for _, d := range ds {
+		summary.Counts[d.GetSeverity()]++
+	}
+	sorted := make([]*rdf.Diagnostic, len(ds))
+	copy(sorted, ds)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if ra, rb := severityRank(a.GetSeverity()), severityRank(b.GetSeverity()); ra != rb {
+			return ra < rb
+		}
+		if a.GetLocation().GetPath() != b.GetLocation().GetPath() {
+			return a.GetLocation().GetPath() < b.GetLocation().GetPath()
+		}
+		pa, pb := a.GetLocation().GetRange().GetStart(), b.GetLocation().GetRange().GetStart()
+		if pa.GetLine() != pb.GetLine() {
+			return pa.GetLine() < pb.GetLine()
+		}
+		return pa.GetColumn() < pb.GetColumn()
+	})
+	if len(sorted) > CheckSummaryTopN {
+		sorted = sorted[:CheckSummaryTopN]
+	}
+	summary.Top = sorted
+	return summary
+}
+
+// severityRank returns rank of severity. Lower is more severe and unknown
+// severity is the least severe.
+func severityRank(s rdf.Severity) int {
+	if s == rdf.Severity_UNKNOWN_SEVERITY {
+		return len(rdf.Severity_name)
+	}
+	return int(s)
+}
@@ -0,0 +1,57 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+func TestBuildCheckSummary(t *testing.T) {
+	diag := func(path string, line int32, sev rdf.Severity) *rdf.Diagnostic {
+		return &rdf.Diagnostic{
+			Message:  fmt.Sprintf("%s:%d", path, line),
+			Location: &rdf.Location{Path: path, Range: &rdf.Range{Start: &rdf.Position{Line: line}}},
+			Severity: sev,
+		}
+	}
+	ds := []*rdf.Diagnostic{
+		diag("b.go", 1, rdf.Severity_INFO),
+		diag("b.go", 2, rdf.Severity_UNKNOWN_SEVERITY),
+		diag("b.go", 3, rdf.Severity_ERROR),
+		diag("a.go", 5, rdf.Severity_WARNING),
+		diag("a.go", 9, rdf.Severity_ERROR),
+		diag("a.go", 1, rdf.Severity_WARNING),
+	}
+	for i := 0; i < CheckSummaryTopN; i++ {
+		ds = append(ds, diag("c.go", int32(i+1), rdf.Severity_INFO))
+	}
+
+	got := BuildCheckSummary(ds)
+	if got.Total != len(ds) {
+		t.Errorf("Total: got %d, want %d", got.Total, len(ds))
+	}
+	wantCounts := map[rdf.Severity]int{
+		rdf.Severity_ERROR:            2,
+		rdf.Severity_WARNING:          2,
+		rdf.Severity_INFO:             CheckSummaryTopN + 1,
+		rdf.Severity_UNKNOWN_SEVERITY: 1,
+	}
+	if diff := cmp.Diff(wantCounts, got.Counts); diff != "" {
+		t.Errorf("Counts diff (-want +got):\n%s", diff)
+	}
+	var top []string
+	for _, d := range got.Top {
+		top = append(top, d.GetMessage())
+	}
+	wantTop := []string{"a.go:9", "b.go:3", "a.go:1", "a.go:5", "b.go:1",
+		"c.go:1", "c.go:2", "c.go:3", "c.go:4", "c.go:5"}
+	if diff := cmp.Diff(wantTop, top); diff != "" {
+		t.Errorf("Top diff (-want +got):\n%s", diff)
+	}
+	if ds[0].GetMessage() != "b.go:1" {
+		t.Error("input diagnostics should not be reordered")
+	}
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &TeamCityParser{}
+
+// TeamCityParser is parser for TeamCity inspection service messages, which
+// golangci-lint reports with --out-format=teamcity.
+//
+//	##teamcity[inspection typeId='errcheck' message='msg' file='main.go' line='15' SEVERITY='ERROR']
+//
+// https://www.jetbrains.com/help/teamcity/service-messages.html#Reporting+Inspections
+type TeamCityParser struct{}
+
+// NewTeamCityParser returns a new TeamCityParser.
+func NewTeamCityParser() *TeamCityParser {
+	return &TeamCityParser{}
+}
+
+var (
+	teamCityInspectionRe = regexp.MustCompile(`^##teamcity\[inspection\s+(.*)\]\s*$`)
+	// name='value' where value escapes ' and other special characters with |.
+	teamCityAttrRe = regexp.MustCompile(`(\w+)='((?:[^'|]|\|.)*)'`)
+)
+
+var teamCityUnescaper = strings.NewReplacer(
+	"|'", "'", "|n", "\n", "|r", "\r", "||", "|", "|[", "[", "|]", "]")
+
+// Parse parses TeamCity inspection service messages. Other service messages
+// (e.g. inspectionType) and lines are ignored.
+func (p *TeamCityParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := teamCityInspectionRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, a := range teamCityAttrRe.FindAllStringSubmatch(m[1], -1) {
+			attrs[a[1]] = teamCityUnescaper.Replace(a[2])
+		}
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: attrs["file"]},
+			Message:        attrs["message"],
+			Severity:       ParseSeverity(attrs["SEVERITY"]),
+			Format:         "teamcity",
+			OriginalOutput: s.Text(),
+		}
+		if lnum, err := strconv.Atoi(attrs["line"]); err == nil && lnum > 0 {
+			d.Location.Range = &rdf.Range{Start: &rdf.Position{Line: int32(lnum)}}
+		}
+		if id := attrs["typeId"]; id != "" {
+			d.Code = &rdf.Code{Value: id}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleTeamCityParser() {
+	// golangci-lint run --out-format=teamcity
+	const sample = `##teamcity[inspectionType id='errcheck' name='errcheck' description='errcheck' category='Golangci-lint reports']
+##teamcity[inspection typeId='errcheck' message='Error return value of |'os.Open|' is not checked' file='main.go' line='15' SEVERITY='ERROR']
+##teamcity[inspectionType id='gocritic' name='gocritic' description='gocritic' category='Golangci-lint reports']
+##teamcity[inspection typeId='gocritic' message='ifElseChain: rewrite if-else to switch statement|nsee |[docs|]' file='pkg/foo.go' line='42' SEVERITY='WEAK WARNING']
+`
+
+	p := NewTeamCityParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Error return value of 'os.Open' is not checked",
+	//   "location": {
+	//     "path": "main.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 15
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "errcheck"
+	//   },
+	//   "originalOutput": "##teamcity[inspection typeId='errcheck' message='Error return value of |'os.Open|' is not checked' file='main.go' line='15' SEVERITY='ERROR']",
+	//   "format": "teamcity"
+	// }
+	// {
+	//   "message": "ifElseChain: rewrite if-else to switch statement\nsee [docs]",
+	//   "location": {
+	//     "path": "pkg/foo.go",
+	//     "range": {
+	//       "start": {
+	//         "line": 42
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "gocritic"
+	//   },
+	//   "originalOutput": "##teamcity[inspection typeId='gocritic' message='ifElseChain: rewrite if-else to switch statement|nsee |[docs|]' file='pkg/foo.go' line='42' SEVERITY='WEAK WARNING']",
+	//   "format": "teamcity"
+	// }
+}
@@ -0,0 +1,87 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &TfsecParser{}
+
+// TfsecParser is parser for tfsec JSON output (tfsec --format=json).
+// https://github.com/aquasecurity/tfsec
+type TfsecParser struct {
+	preserveRaw bool
+}
+
+// NewTfsecParser returns a new TfsecParser.
+func NewTfsecParser() *TfsecParser {
+	return &TfsecParser{}
+}
+
+// Parse parses tfsec JSON output.
+func (p *TfsecParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var out TfsecOutput
+	if err := json.NewDecoder(r).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode tfsec JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, res := range out.Results {
+		loc := res.Location
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: loc.Filename,
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: int32(loc.StartLine)},
+				},
+			},
+			Message:        res.Description,
+			Severity:       ParseSeverity(res.Severity),
+			Format:         "tfsec",
+			OriginalOutput: fmt.Sprintf("%s:%d-%d: %s: %s (%s)", loc.Filename, loc.StartLine, loc.EndLine, res.Severity, res.Description, res.RuleID),
+		}
+		if loc.EndLine > loc.StartLine {
+			d.Location.Range.End = &rdf.Position{Line: int32(loc.EndLine)}
+		}
+		if res.RuleID != "" {
+			d.Code = &rdf.Code{Value: res.RuleID}
+			if len(res.Links) > 0 {
+				d.Code.Url = res.Links[0]
+			}
+		}
+		if p.preserveRaw {
+			d.Raw = res.raw
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// TfsecOutput represents tfsec JSON output.
+// {"results":[{"rule_id":"AVD-AWS-0086","description":"No public access block so not blocking public acls","severity":"HIGH","links":["https://aquasecurity.github.io/tfsec/latest/checks/aws/s3/block-public-acls/"],"location":{"filename":"main.tf","start_line":1,"end_line":4}}]}
+type TfsecOutput struct {
+	Results []*TfsecResult `json:"results"`
+}
+
+// TfsecResult represents a tfsec finding. Lines are 1-based.
+type TfsecResult struct {
+	RuleID      string   `json:"rule_id"`
+	Description string   `json:"description"`
+	Severity    string   `json:"severity"`
+	Links       []string `json:"links"`
+	Location    struct {
+		Filename  string `json:"filename"`
+		StartLine int    `json:"start_line"`
+		EndLine   int    `json:"end_line"`
+	} `json:"location"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler to keep the raw JSON.
+func (r *TfsecResult) UnmarshalJSON(b []byte) error {
+	type result TfsecResult // Avoid recursion.
+	return unmarshalRaw(b, (*result)(r), &r.raw)
+}
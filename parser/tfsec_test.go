@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleTfsecParser() {
+	// tfsec --format=json .
+	const sample = `{
+  "results": [
+    {
+      "rule_id": "AVD-AWS-0086",
+      "long_id": "aws-s3-block-public-acls",
+      "rule_description": "S3 Access block should block public ACL",
+      "rule_provider": "aws",
+      "rule_service": "s3",
+      "impact": "PUT calls with public ACLs specified can make objects public",
+      "resolution": "Enable blocking any PUT calls with a public ACL specified",
+      "links": [
+        "https://aquasecurity.github.io/tfsec/latest/checks/aws/s3/block-public-acls/",
+        "https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/s3_bucket_public_access_block#block_public_acls"
+      ],
+      "description": "No public access block so not blocking public acls",
+      "severity": "HIGH",
+      "warning": false,
+      "status": 0,
+      "resource": "aws_s3_bucket.example",
+      "location": {
+        "filename": "/src/main.tf",
+        "start_line": 1,
+        "end_line": 4
+      }
+    },
+    {
+      "rule_id": "AVD-AWS-0132",
+      "description": "Bucket does not encrypt data with a customer managed key.",
+      "severity": "LOW",
+      "links": [],
+      "location": {
+        "filename": "/src/main.tf",
+        "start_line": 6,
+        "end_line": 6
+      }
+    }
+  ]
+}`
+
+	p := NewTfsecParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "No public access block so not blocking public acls",
+	//   "location": {
+	//     "path": "/src/main.tf",
+	//     "range": {
+	//       "start": {
+	//         "line": 1
+	//       },
+	//       "end": {
+	//         "line": 4
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "AVD-AWS-0086",
+	//     "url": "https://aquasecurity.github.io/tfsec/latest/checks/aws/s3/block-public-acls/"
+	//   },
+	//   "originalOutput": "/src/main.tf:1-4: HIGH: No public access block so not blocking public acls (AVD-AWS-0086)",
+	//   "format": "tfsec"
+	// }
+	// {
+	//   "message": "Bucket does not encrypt data with a customer managed key.",
+	//   "location": {
+	//     "path": "/src/main.tf",
+	//     "range": {
+	//       "start": {
+	//         "line": 6
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "code": {
+	//     "value": "AVD-AWS-0132"
+	//   },
+	//   "originalOutput": "/src/main.tf:6-6: LOW: Bucket does not encrypt data with a customer managed key. (AVD-AWS-0132)",
+	//   "format": "tfsec"
+	// }
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &TSCParser{}
+
+// TSCParser is parser for TypeScript compiler output (tsc --pretty false).
+// https://www.typescriptlang.org/docs/handbook/compiler-options.html
+type TSCParser struct{}
+
+// NewTSCParser returns a new TSCParser.
+func NewTSCParser() *TSCParser {
+	return &TSCParser{}
+}
+
+// path(line,col): error TS2322: message
+var tscRe = regexp.MustCompile(`^(.+?)\((\d+),(\d+)\): (error|warning|message) (TS\d+): (.*)$`)
+
+// Parse parses tsc output. Indented lines following a diagnostic line are
+// treated as continuation of the message. Other lines such as the summary
+// ("Found 2 errors.") are ignored.
+func (p *TSCParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	var cur *rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		m := tscRe.FindStringSubmatch(line)
+		if m == nil {
+			if cur != nil && strings.HasPrefix(line, " ") {
+				cur.Message += "\n" + strings.TrimSpace(line)
+				cur.OriginalOutput += "\n" + line
+			} else {
+				cur = nil
+			}
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		cur = &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[6],
+			Severity:       tscSeverity(m[4]),
+			Code:           &rdf.Code{Value: m[5]},
+			Format:         "tsc-text",
+			OriginalOutput: line,
+		}
+		ds = append(ds, cur)
+	}
+	return ds, s.Err()
+}
+
+func tscSeverity(s string) rdf.Severity {
+	switch s {
+	case "error":
+		return rdf.Severity_ERROR
+	case "warning":
+		return rdf.Severity_WARNING
+	default:
+		return rdf.Severity_INFO
+	}
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleTSCParser() {
+	const sample = `src/index.ts(3,7): error TS2322: Type 'string' is not assignable to type 'number'.
+src/util.ts(10,15): error TS2345: Argument of type '{ a: string; }' is not assignable to parameter of type 'Options'.
+  Object literal may only specify known properties, and 'a' does not exist in type 'Options'.
+
+Found 2 errors.
+`
+
+	p := NewTSCParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Type 'string' is not assignable to type 'number'.",
+	//   "location": {
+	//     "path": "src/index.ts",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 7
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "TS2322"
+	//   },
+	//   "originalOutput": "src/index.ts(3,7): error TS2322: Type 'string' is not assignable to type 'number'.",
+	//   "format": "tsc-text"
+	// }
+	// {
+	//   "message": "Argument of type '{ a: string; }' is not assignable to parameter of type 'Options'.\nObject literal may only specify known properties, and 'a' does not exist in type 'Options'.",
+	//   "location": {
+	//     "path": "src/util.ts",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 15
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "TS2345"
+	//   },
+	//   "originalOutput": "src/util.ts(10,15): error TS2345: Argument of type '{ a: string; }' is not assignable to parameter of type 'Options'.\n  Object literal may only specify known properties, and 'a' does not exist in type 'Options'.",
+	//   "format": "tsc-text"
+	// }
+}
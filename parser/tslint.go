@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &TSLintParser{}
+
+// TSLintParser is parser for tslint JSON output (tslint -t json).
+// https://palantir.github.io/tslint/
+type TSLintParser struct {
+	preserveRaw bool
+}
+
+// NewTSLintParser returns a new TSLintParser.
+func NewTSLintParser() *TSLintParser {
+	return &TSLintParser{}
+}
+
+// Parse parses tslint JSON output.
+func (p *TSLintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var failures []*TSLintFailure
+	if err := json.NewDecoder(r).Decode(&failures); err != nil {
+		return nil, fmt.Errorf("failed to decode tslint JSON: %w", err)
+	}
+	var ds []*rdf.Diagnostic
+	for _, f := range failures {
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: f.Name,
+				Range: &rdf.Range{
+					Start: f.StartPosition.rdfPosition(),
+					End:   f.EndPosition.rdfPosition(),
+				},
+			},
+			Message:  f.Failure,
+			Severity: ParseSeverity(f.RuleSeverity),
+			Format:   "tslint-json",
+			OriginalOutput: fmt.Sprintf("%s: %s[%d, %d]: %s", f.RuleSeverity, f.Name,
+				f.StartPosition.Line+1, f.StartPosition.Character+1, f.Failure),
+		}
+		if f.RuleName != "" {
+			d.Code = &rdf.Code{Value: f.RuleName}
+		}
+		if p.preserveRaw {
+			d.Raw = f.raw
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
+
+// TSLintFailure represents a failure of tslint JSON output.
+// {"name":"src/a.ts","ruleName":"semicolon","ruleSeverity":"ERROR","failure":"Missing semicolon","startPosition":{"line":0,"character":12,"position":12},"endPosition":{"line":0,"character":12,"position":12}}
+type TSLintFailure struct {
+	Name          string         `json:"name"`
+	RuleName      string         `json:"ruleName"`
+	RuleSeverity  string         `json:"ruleSeverity"`
+	Failure       string         `json:"failure"`
+	StartPosition TSLintPosition `json:"startPosition"`
+	EndPosition   TSLintPosition `json:"endPosition"`
+
+	raw json.RawMessage
+}
+
+// UnmarshalJSON implements json.Unmarshaler to keep the raw JSON.
+func (f *TSLintFailure) UnmarshalJSON(b []byte) error {
+	type failure TSLintFailure // Avoid recursion.
+	return unmarshalRaw(b, (*failure)(f), &f.raw)
+}
+
+// TSLintPosition represents a position. Both line and character are 0-based.
+type TSLintPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+	Position  int `json:"position"`
+}
+
+func (p TSLintPosition) rdfPosition() *rdf.Position {
+	return &rdf.Position{Line: int32(p.Line + 1), Column: int32(p.Character + 1)}
+}
@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleTSLintParser() {
+	// tslint -t json 'src/**/*.ts'
+	const sample = `[
+  {
+    "endPosition": {"character": 13, "line": 0, "position": 13},
+    "failure": "Missing semicolon",
+    "fix": {"innerStart": 13, "innerLength": 0, "innerText": ";"},
+    "name": "src/index.ts",
+    "ruleName": "semicolon",
+    "ruleSeverity": "ERROR",
+    "startPosition": {"character": 13, "line": 0, "position": 13}
+  },
+  {
+    "endPosition": {"character": 15, "line": 4, "position": 80},
+    "failure": "Forbidden 'var' keyword, use 'let' or 'const' instead",
+    "name": "src/util.ts",
+    "ruleName": "no-var-keyword",
+    "ruleSeverity": "WARNING",
+    "startPosition": {"character": 2, "line": 4, "position": 67}
+  }
+]`
+
+	p := NewTSLintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Missing semicolon",
+	//   "location": {
+	//     "path": "src/index.ts",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 14
+	//       },
+	//       "end": {
+	//         "line": 1,
+	//         "column": 14
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "semicolon"
+	//   },
+	//   "originalOutput": "ERROR: src/index.ts[1, 14]: Missing semicolon",
+	//   "format": "tslint-json"
+	// }
+	// {
+	//   "message": "Forbidden 'var' keyword, use 'let' or 'const' instead",
+	//   "location": {
+	//     "path": "src/util.ts",
+	//     "range": {
+	//       "start": {
+	//         "line": 5,
+	//         "column": 3
+	//       },
+	//       "end": {
+	//         "line": 5,
+	//         "column": 16
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "no-var-keyword"
+	//   },
+	//   "originalOutput": "WARNING: src/util.ts[5, 3]: Forbidden 'var' keyword, use 'let' or 'const' instead",
+	//   "format": "tslint-json"
+	// }
+}
@@ -0,0 +1,91 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &TyposParser{}
+
+// TyposParser is parser for typos JSON output (typos --format json), which is
+// JSON Lines of TyposMessage.
+// https://github.com/crate-ci/typos
+type TyposParser struct{}
+
+// NewTyposParser returns a new TyposParser.
+func NewTyposParser() *TyposParser {
+	return &TyposParser{}
+}
+
+// Parse parses typos JSON output. Typos are reported as INFO with a
+// suggestion for each correction. Messages other than typos (e.g.
+// "binary_file") are ignored.
+func (p *TyposParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		var msg TyposMessage
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			return nil, fmt.Errorf("failed to decode typos JSON: %w", err)
+		}
+		if msg.Type != "typo" {
+			continue
+		}
+		// byte_offset is a 0-based byte offset in the line, which is a byte
+		// column as other formats use.
+		col := int32(msg.ByteOffset + 1)
+		lnum := int32(msg.LineNum)
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: msg.Path,
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: lnum, Column: col},
+				},
+			},
+			Message:        typosMessage(msg),
+			Severity:       rdf.Severity_INFO,
+			Format:         "typos",
+			OriginalOutput: line,
+		}
+		for _, c := range msg.Corrections {
+			d.Suggestions = append(d.Suggestions, &rdf.Suggestion{
+				Range: &rdf.Range{
+					Start: &rdf.Position{Line: lnum, Column: col},
+					End:   &rdf.Position{Line: lnum, Column: col + int32(len(msg.Typo))},
+				},
+				Text: c,
+			})
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
+
+// typosMessage returns the message of the typo as typos reports in text
+// mode, e.g. "`teh` should be `the`".
+func typosMessage(msg TyposMessage) string {
+	if len(msg.Corrections) == 0 {
+		return fmt.Sprintf("`%s` is disallowed", msg.Typo)
+	}
+	return fmt.Sprintf("`%s` should be `%s`", msg.Typo, strings.Join(msg.Corrections, "`, `"))
+}
+
+// TyposMessage represents a line of typos JSON output.
+// {"type":"typo","path":"README.md","line_num":3,"byte_offset":10,"typo":"teh","corrections":["the"]}
+type TyposMessage struct {
+	Type        string   `json:"type"`
+	Path        string   `json:"path"`
+	LineNum     int      `json:"line_num"`
+	ByteOffset  int      `json:"byte_offset"`
+	Typo        string   `json:"typo"`
+	Corrections []string `json:"corrections"`
+}
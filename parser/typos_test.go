@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleTyposParser() {
+	// typos --format json
+	const sample = `{"type":"binary_file","path":"logo.png"}
+{"type":"typo","path":"README.md","line_num":3,"byte_offset":10,"typo":"teh","corrections":["the"]}
+{"type":"typo","path":"src/main.rs","line_num":12,"byte_offset":4,"typo":"ba","corrections":["by","be"]}
+`
+
+	p := NewTyposParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "`teh` should be `the`",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 11
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 3,
+	//           "column": 11
+	//         },
+	//         "end": {
+	//           "line": 3,
+	//           "column": 14
+	//         }
+	//       },
+	//       "text": "the"
+	//     }
+	//   ],
+	//   "originalOutput": "{\"type\":\"typo\",\"path\":\"README.md\",\"line_num\":3,\"byte_offset\":10,\"typo\":\"teh\",\"corrections\":[\"the\"]}",
+	//   "format": "typos"
+	// }
+	// {
+	//   "message": "`ba` should be `by`, `be`",
+	//   "location": {
+	//     "path": "src/main.rs",
+	//     "range": {
+	//       "start": {
+	//         "line": 12,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "INFO",
+	//   "suggestions": [
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 12,
+	//           "column": 5
+	//         },
+	//         "end": {
+	//           "line": 12,
+	//           "column": 7
+	//         }
+	//       },
+	//       "text": "by"
+	//     },
+	//     {
+	//       "range": {
+	//         "start": {
+	//           "line": 12,
+	//           "column": 5
+	//         },
+	//         "end": {
+	//           "line": 12,
+	//           "column": 7
+	//         }
+	//       },
+	//       "text": "be"
+	//     }
+	//   ],
+	//   "originalOutput": "{\"type\":\"typo\",\"path\":\"src/main.rs\",\"line_num\":12,\"byte_offset\":4,\"typo\":\"ba\",\"corrections\":[\"by\",\"be\"]}",
+	//   "format": "typos"
+	// }
+}
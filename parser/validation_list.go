@@ -0,0 +1,90 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &ValidationListParser{}
+
+// ValidationListOption configures keywords of ValidationListParser. Default
+// keywords are used for empty fields.
+type ValidationListOption struct {
+	// ErrorKeywords are keywords of failed validations, reported as ERROR.
+	// Default: FAIL, ERROR, ERR.
+	ErrorKeywords []string
+	// WarningKeywords are keywords of warnings, reported as WARNING.
+	// Default: WARN, WARNING.
+	WarningKeywords []string
+}
+
+// ValidationListParser is parser for validation results listed per file or
+// document by tools like kubeval and yamale. It supports the following
+// forms of lines and ignores others (e.g. PASS lines):
+//
+//	KEYWORD - path: message
+//	path: KEYWORD: message
+//
+// Diagnostics are file-level as such tools don't report positions.
+type ValidationListParser struct {
+	prefixRe *regexp.Regexp
+	infixRe  *regexp.Regexp
+	severity map[string]rdf.Severity
+}
+
+// NewValidationListParser returns a new ValidationListParser.
+func NewValidationListParser(opt ValidationListOption) *ValidationListParser {
+	errKeywords := opt.ErrorKeywords
+	if len(errKeywords) == 0 {
+		errKeywords = []string{"FAIL", "ERROR", "ERR"}
+	}
+	warnKeywords := opt.WarningKeywords
+	if len(warnKeywords) == 0 {
+		warnKeywords = []string{"WARN", "WARNING"}
+	}
+	p := &ValidationListParser{severity: make(map[string]rdf.Severity)}
+	var quoted []string
+	for _, kw := range errKeywords {
+		p.severity[kw] = rdf.Severity_ERROR
+		quoted = append(quoted, regexp.QuoteMeta(kw))
+	}
+	for _, kw := range warnKeywords {
+		p.severity[kw] = rdf.Severity_WARNING
+		quoted = append(quoted, regexp.QuoteMeta(kw))
+	}
+	kws := strings.Join(quoted, "|")
+	p.prefixRe = regexp.MustCompile(`^(` + kws + `)\s+-\s+([^\s:]+):?\s*(.*)$`)
+	p.infixRe = regexp.MustCompile(`^(.+?): (` + kws + `): (.*)$`)
+	return p
+}
+
+// Parse parses validation results.
+func (p *ValidationListParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		var keyword, path, msg string
+		if m := p.prefixRe.FindStringSubmatch(s.Text()); m != nil {
+			keyword, path, msg = m[1], m[2], m[3]
+		} else if m := p.infixRe.FindStringSubmatch(s.Text()); m != nil {
+			path, keyword, msg = m[1], m[2], m[3]
+		} else {
+			continue
+		}
+		if msg == "" {
+			msg = keyword
+		}
+		ds = append(ds, &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: path},
+			Message:        msg,
+			Severity:       p.severity[keyword],
+			Format:         "validation-list",
+			OriginalOutput: s.Text(),
+		})
+	}
+	return ds, s.Err()
+}
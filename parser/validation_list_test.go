@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleValidationListParser() {
+	// kubeval and yamale style results.
+	const sample = `PASS - deploy/service.yaml contains a valid Service (default.web)
+ERR  - deploy/deployment.yaml: Missing 'metadata' key
+WARN - deploy/crd.yaml containing a CustomResourceDefinition was not validated against a schema
+data/config.yaml: ERROR: name: Required field missing
+data/other.yaml: PASS
+`
+
+	p := NewValidationListParser(ValidationListOption{})
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Missing 'metadata' key",
+	//   "location": {
+	//     "path": "deploy/deployment.yaml"
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "ERR  - deploy/deployment.yaml: Missing 'metadata' key",
+	//   "format": "validation-list"
+	// }
+	// {
+	//   "message": "containing a CustomResourceDefinition was not validated against a schema",
+	//   "location": {
+	//     "path": "deploy/crd.yaml"
+	//   },
+	//   "severity": "WARNING",
+	//   "originalOutput": "WARN - deploy/crd.yaml containing a CustomResourceDefinition was not validated against a schema",
+	//   "format": "validation-list"
+	// }
+	// {
+	//   "message": "name: Required field missing",
+	//   "location": {
+	//     "path": "data/config.yaml"
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "data/config.yaml: ERROR: name: Required field missing",
+	//   "format": "validation-list"
+	// }
+}
+
+func ExampleValidationListParser_customKeywords() {
+	const sample = `OK - a.yaml
+NG - b.yaml: invalid type
+`
+
+	p := NewValidationListParser(ValidationListOption{ErrorKeywords: []string{"NG"}})
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "invalid type",
+	//   "location": {
+	//     "path": "b.yaml"
+	//   },
+	//   "severity": "ERROR",
+	//   "originalOutput": "NG - b.yaml: invalid type",
+	//   "format": "validation-list"
+	// }
+}
@@ -0,0 +1,70 @@
+package parser
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &VintParser{}
+
+// VintParser is parser for vint (Vim script linter) output.
+// https://github.com/Vimjas/vint
+type VintParser struct{}
+
+// NewVintParser returns a new VintParser.
+func NewVintParser() *VintParser {
+	return &VintParser{}
+}
+
+var (
+	// path:line:col: [Severity: ]message (policy)
+	vintRe = regexp.MustCompile(`^(.+?):(\d+):(\d+): (.*?)(?: \(([^()]+)\))?$`)
+	// Warning: message
+	vintSeverityRe = regexp.MustCompile(`^(Error|Warning|Style(?:Problem)?): (.*)$`)
+)
+
+// Parse parses vint output.
+func (p *VintParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		m := vintRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		d := &rdf.Diagnostic{
+			Location: &rdf.Location{
+				Path: m[1],
+				Range: &rdf.Range{
+					Start: &rdf.Position{
+						Line:   int32(lnum),
+						Column: int32(col),
+					},
+				},
+			},
+			Message:        m[4],
+			Format:         "vint",
+			OriginalOutput: s.Text(),
+		}
+		if sm := vintSeverityRe.FindStringSubmatch(d.Message); sm != nil {
+			d.Message = sm[2]
+			if strings.HasPrefix(sm[1], "Style") {
+				d.Severity = rdf.Severity_INFO
+			} else {
+				d.Severity = severity(sm[1])
+			}
+		}
+		if policy := m[5]; policy != "" {
+			d.Code = &rdf.Code{Value: policy}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
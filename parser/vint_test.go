@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleVintParser() {
+	const sample = `autoload/foo.vim:3:1: Warning: Use scriptencoding when multibyte char exists (ProhibitMissingScriptEncoding)
+autoload/foo.vim:10:5: Error: Undefined variable: s:bar (ProhibitUsingUndeclaredVariable)
+plugin/foo.vim:1:1: Use the full option name instead of the abbreviation (ProhibitAbbreviationOption)
+`
+
+	p := NewVintParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "Use scriptencoding when multibyte char exists",
+	//   "location": {
+	//     "path": "autoload/foo.vim",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "ProhibitMissingScriptEncoding"
+	//   },
+	//   "originalOutput": "autoload/foo.vim:3:1: Warning: Use scriptencoding when multibyte char exists (ProhibitMissingScriptEncoding)",
+	//   "format": "vint"
+	// }
+	// {
+	//   "message": "Undefined variable: s:bar",
+	//   "location": {
+	//     "path": "autoload/foo.vim",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 5
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "ProhibitUsingUndeclaredVariable"
+	//   },
+	//   "originalOutput": "autoload/foo.vim:10:5: Error: Undefined variable: s:bar (ProhibitUsingUndeclaredVariable)",
+	//   "format": "vint"
+	// }
+	// {
+	//   "message": "Use the full option name instead of the abbreviation",
+	//   "location": {
+	//     "path": "plugin/foo.vim",
+	//     "range": {
+	//       "start": {
+	//         "line": 1,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "code": {
+	//     "value": "ProhibitAbbreviationOption"
+	//   },
+	//   "originalOutput": "plugin/foo.vim:1:1: Use the full option name instead of the abbreviation (ProhibitAbbreviationOption)",
+	//   "format": "vint"
+	// }
+}
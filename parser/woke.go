@@ -0,0 +1,144 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+
+	"github.com/reviewdog/reviewdog/proto/rdf"
+)
+
+var _ Parser = &WokeParser{}
+
+// path:line:col[-endcol]: [severity] message (rule)
+var wokeLineRe = regexp.MustCompile(`^(.+?):(\d+):(\d+)(?:-(\d+))?: \[(\w+)\] (.*?)(?: \(([\w-]+)\))?$`)
+
+// WokeParser is parser for woke text and JSON output (woke -o json).
+//
+//	path:line:col: [severity] message (rule)
+//
+// https://github.com/get-woke/woke
+type WokeParser struct{}
+
+// NewWokeParser returns a new WokeParser.
+func NewWokeParser() *WokeParser {
+	return &WokeParser{}
+}
+
+// Parse parses woke output. Input starting with "{" is parsed as a stream of
+// JSON results per file, otherwise as text output whose lines in other
+// formats are ignored.
+func (p *WokeParser) Parse(r io.Reader) ([]*rdf.Diagnostic, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(b); len(trimmed) > 0 && trimmed[0] == '{' {
+		return p.parseJSON(trimmed)
+	}
+	return p.parseText(b)
+}
+
+func (p *WokeParser) parseText(b []byte) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		line := s.Text()
+		m := wokeLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lnum, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		rng := &rdf.Range{Start: &rdf.Position{Line: int32(lnum), Column: int32(col)}}
+		if m[4] != "" {
+			ecol, _ := strconv.Atoi(m[4])
+			rng.End = &rdf.Position{Line: int32(lnum), Column: int32(ecol)}
+		}
+		d := &rdf.Diagnostic{
+			Location:       &rdf.Location{Path: m[1], Range: rng},
+			Message:        m[6],
+			Severity:       ParseSeverity(m[5]),
+			Format:         "woke",
+			OriginalOutput: line,
+		}
+		if m[7] != "" {
+			d.Code = &rdf.Code{Value: m[7]}
+		}
+		ds = append(ds, d)
+	}
+	return ds, s.Err()
+}
+
+func (p *WokeParser) parseJSON(b []byte) ([]*rdf.Diagnostic, error) {
+	var ds []*rdf.Diagnostic
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var file WokeFileResult
+		if err := dec.Decode(&file); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode woke JSON: %w", err)
+		}
+		for _, result := range file.Results {
+			start, end := result.StartPosition, result.EndPosition
+			if start == nil {
+				start = &WokePosition{}
+			}
+			d := &rdf.Diagnostic{
+				Location: &rdf.Location{
+					Path: file.Filename,
+					Range: &rdf.Range{
+						Start: &rdf.Position{Line: int32(start.Line), Column: int32(start.Column)},
+					},
+				},
+				Message:  result.Reason,
+				Severity: ParseSeverity(result.Rule.Severity),
+				Format:   "woke",
+				OriginalOutput: fmt.Sprintf("%s:%d:%d: [%s] %s (%s)", file.Filename,
+					start.Line, start.Column, result.Rule.Severity, result.Reason, result.Rule.Name),
+			}
+			if end != nil && end.Line > 0 {
+				d.Location.Range.End = &rdf.Position{Line: int32(end.Line), Column: int32(end.Column)}
+			}
+			if result.Rule.Name != "" {
+				d.Code = &rdf.Code{Value: result.Rule.Name}
+			}
+			ds = append(ds, d)
+		}
+	}
+	return ds, nil
+}
+
+// WokeFileResult represents woke JSON output of a file.
+// {"Filename":"README.md","Results":[{"Rule":{"Name":"whitelist","Severity":"warning"},"Finding":"whitelist","Line":"add to the whitelist","StartPosition":{"Line":3,"Column":11},"EndPosition":{"Line":3,"Column":20},"Reason":"`whitelist` may be insensitive, use `allowlist` instead"}]}
+type WokeFileResult struct {
+	Filename string        `json:"Filename"`
+	Results  []*WokeResult `json:"Results"`
+}
+
+// WokeResult represents a finding of a rule.
+type WokeResult struct {
+	Rule          WokeRule      `json:"Rule"`
+	Finding       string        `json:"Finding"`
+	StartPosition *WokePosition `json:"StartPosition"`
+	EndPosition   *WokePosition `json:"EndPosition"`
+	Reason        string        `json:"Reason"`
+}
+
+// WokeRule represents a rule of woke.
+type WokeRule struct {
+	Name     string `json:"Name"`
+	Severity string `json:"Severity"`
+}
+
+// WokePosition represents a position. Lines and columns are 1-based.
+type WokePosition struct {
+	Line   int `json:"Line"`
+	Column int `json:"Column"`
+}
@@ -0,0 +1,134 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func ExampleWokeParser() {
+	// woke
+	const sample = `README.md:3:11-20: [warning] ` + "`whitelist`" + ` may be insensitive, use ` + "`allowlist`" + ` instead (whitelist)
+add to the whitelist
+          ^
+docs/setup.md:10:1: [error] ` + "`master`" + ` may be insensitive, use ` + "`primary`" + ` instead (master-slave)`
+
+	p := NewWokeParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "`whitelist` may be insensitive, use `allowlist` instead",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 11
+	//       },
+	//       "end": {
+	//         "line": 3,
+	//         "column": 20
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "whitelist"
+	//   },
+	//   "originalOutput": "README.md:3:11-20: [warning] `whitelist` may be insensitive, use `allowlist` instead (whitelist)",
+	//   "format": "woke"
+	// }
+	// {
+	//   "message": "`master` may be insensitive, use `primary` instead",
+	//   "location": {
+	//     "path": "docs/setup.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 1
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "master-slave"
+	//   },
+	//   "originalOutput": "docs/setup.md:10:1: [error] `master` may be insensitive, use `primary` instead (master-slave)",
+	//   "format": "woke"
+	// }
+}
+
+func ExampleWokeParser_json() {
+	// woke -o json
+	const sample = `{"Filename":"README.md","Results":[{"Rule":{"Name":"whitelist","Terms":["whitelist","white-list"],"Alternatives":["allowlist"],"Severity":"warning"},"Finding":"whitelist","Line":"add to the whitelist","StartPosition":{"Filename":"README.md","Offset":0,"Line":3,"Column":11},"EndPosition":{"Filename":"README.md","Offset":0,"Line":3,"Column":20},"Reason":"` + "`whitelist`" + ` may be insensitive, use ` + "`allowlist`" + ` instead"}]}
+{"Filename":"docs/setup.md","Results":[{"Rule":{"Name":"master-slave","Severity":"error"},"Finding":"master","Line":"master","StartPosition":{"Line":10,"Column":1},"EndPosition":{"Line":10,"Column":7},"Reason":"` + "`master`" + ` may be insensitive, use ` + "`primary`" + ` instead"}]}`
+
+	p := NewWokeParser()
+	diagnostics, err := p.Parse(strings.NewReader(sample))
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range diagnostics {
+		rdjson, _ := protojson.MarshalOptions{Indent: "  "}.Marshal(d)
+		var out bytes.Buffer
+		json.Indent(&out, rdjson, "", "  ")
+		fmt.Println(out.String())
+	}
+	// Output:
+	// {
+	//   "message": "`whitelist` may be insensitive, use `allowlist` instead",
+	//   "location": {
+	//     "path": "README.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 3,
+	//         "column": 11
+	//       },
+	//       "end": {
+	//         "line": 3,
+	//         "column": 20
+	//       }
+	//     }
+	//   },
+	//   "severity": "WARNING",
+	//   "code": {
+	//     "value": "whitelist"
+	//   },
+	//   "originalOutput": "README.md:3:11: [warning] `whitelist` may be insensitive, use `allowlist` instead (whitelist)",
+	//   "format": "woke"
+	// }
+	// {
+	//   "message": "`master` may be insensitive, use `primary` instead",
+	//   "location": {
+	//     "path": "docs/setup.md",
+	//     "range": {
+	//       "start": {
+	//         "line": 10,
+	//         "column": 1
+	//       },
+	//       "end": {
+	//         "line": 10,
+	//         "column": 7
+	//       }
+	//     }
+	//   },
+	//   "severity": "ERROR",
+	//   "code": {
+	//     "value": "master-slave"
+	//   },
+	//   "originalOutput": "docs/setup.md:10:1: [error] `master` may be insensitive, use `primary` instead (master-slave)",
+	//   "format": "woke"
+	// }
+}